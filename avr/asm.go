@@ -1,5 +1,253 @@
 package avr
 
+import (
+	"fmt"
+	"strings"
+)
+
+// operands for Encode.
+
+func Reg(n int) Operand {
+	return Operand{Kind: OperandRegister, Value: n}
+}
+
+func Imm(v int) Operand {
+	return Operand{Kind: OperandImmediate, Value: v}
+}
+
+func IO(addr int) Operand {
+	return Operand{Kind: OperandIO, Value: addr}
+}
+
+func Data(addr int) Operand {
+	return Operand{Kind: OperandData, Value: addr}
+}
+
+func Bit(n int) Operand {
+	return Operand{Kind: OperandBit, Value: n}
+}
+
+// Rel is a program offset in bytes, relative to the following instruction.
+func Rel(offset int) Operand {
+	return Operand{Kind: OperandRelative, Value: offset}
+}
+
+// Abs is a program address in bytes.
+func Abs(addr int) Operand {
+	return Operand{Kind: OperandAbsolute, Value: addr}
+}
+
+// Ptr is a pointer register, as written in assembly: "X", "Y+", "-Z"...
+func Ptr(p string) Operand {
+	return Operand{Kind: OperandPointer, Pointer: p}
+}
+
+// Disp is a pointer register ("Y" or "Z") with a displacement, for LDD and
+// STD.
+func Disp(p string, q int) Operand {
+	return Operand{Kind: OperandPointer, Pointer: p, Value: q}
+}
+
+func checkRange(spec string, v int, min int, max int) error {
+	if v < min || v > max {
+		return fmt.Errorf("operand out of range for %s (%d..%d): %d", spec, min, max, v)
+	}
+	return nil
+}
+
+func checkKind(spec string, o Operand, kinds ...OperandKind) error {
+	for _, k := range kinds {
+		if o.Kind == k {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid operand for %s: %s", spec, o)
+}
+
+// encodeOperand returns the bits of the operand for the first word, and the
+// second word, for two-word instructions.
+func encodeOperand(addr uint16, spec string, o Operand) (uint16, uint16, error) {
+	reg := func(min int, max int, step int) (int, error) {
+		if err := checkKind(spec, o, OperandRegister); err != nil {
+			return 0, err
+		}
+		if err := checkRange(spec, o.Value, min, max); err != nil {
+			return 0, err
+		}
+		if (o.Value-min)%step != 0 {
+			return 0, fmt.Errorf("register must be even for %s: %s", spec, o)
+		}
+		return (o.Value - min) / step, nil
+	}
+	num := func(min int, max int, kinds ...OperandKind) (int, error) {
+		if err := checkKind(spec, o, append(kinds, OperandImmediate)...); err != nil {
+			return 0, err
+		}
+		return o.Value, checkRange(spec, o.Value, min, max)
+	}
+	target := func(bits uint) (int, error) {
+		off := o.Value
+		switch o.Kind {
+		case OperandAbsolute, OperandImmediate:
+			off = o.Value - int(addr) - 2
+		case OperandRelative:
+		default:
+			return 0, checkKind(spec, o, OperandRelative, OperandAbsolute)
+		}
+		if off%2 != 0 {
+			return 0, fmt.Errorf("program address must be even: %s", o)
+		}
+		max := 1 << bits
+		return (off / 2) & (max - 1), checkRange(spec, off, -max, max-2)
+	}
+
+	switch spec {
+	case "Rd":
+		v, err := reg(0, 31, 1)
+		return uint16(v) << 4, 0, err
+	case "Rr":
+		v, err := reg(0, 31, 1)
+		return uint16(v&0x0f) | uint16(v&0x10)<<5, 0, err
+	case "Rdh":
+		v, err := reg(16, 31, 1)
+		return uint16(v) << 4, 0, err
+	case "Rrh":
+		v, err := reg(16, 31, 1)
+		return uint16(v), 0, err
+	case "Rd3":
+		v, err := reg(16, 23, 1)
+		return uint16(v) << 4, 0, err
+	case "Rr3":
+		v, err := reg(16, 23, 1)
+		return uint16(v), 0, err
+	case "Rdw":
+		v, err := reg(0, 30, 2)
+		return uint16(v) << 4, 0, err
+	case "Rrw":
+		v, err := reg(0, 30, 2)
+		return uint16(v), 0, err
+	case "Rdiw":
+		v, err := reg(24, 30, 2)
+		return uint16(v) << 4, 0, err
+	case "K4":
+		v, err := num(0, 15)
+		return uint16(v) << 4, 0, err
+	case "K6":
+		v, err := num(0, 63)
+		return uint16(v&0x0f) | uint16(v&0x30)<<2, 0, err
+	case "K8":
+		// negative values are accepted, as in "subi r16, -1".
+		v, err := num(-128, 255)
+		v &= 0xff
+		return uint16(v&0x0f) | uint16(v&0xf0)<<4, 0, err
+	case "A5":
+		v, err := num(0, 31, OperandIO)
+		return uint16(v) << 3, 0, err
+	case "A6":
+		v, err := num(0, 63, OperandIO)
+		return uint16(v&0x0f) | uint16(v&0x30)<<5, 0, err
+	case "b":
+		v, err := num(0, 7, OperandBit)
+		return uint16(v), 0, err
+	case "k7":
+		v, err := target(7)
+		return uint16(v) << 3, 0, err
+	case "k12":
+		v, err := target(12)
+		return uint16(v), 0, err
+	case "k16":
+		v, err := num(0, 0xffff, OperandData)
+		return 0, uint16(v), err
+	case "k22":
+		v, err := num(0, 0x7ffffe, OperandAbsolute)
+		if err == nil && v%2 != 0 {
+			err = fmt.Errorf("program address must be even: %s", o)
+		}
+		w := v / 2
+		return uint16((w>>17)&0x1f)<<4 | uint16((w>>16)&0x01), uint16(w), err
+	case "Y+q", "Z+q":
+		if err := checkKind(spec, o, OperandPointer); err != nil {
+			return 0, 0, err
+		}
+		if o.Pointer != spec[:1] {
+			return 0, 0, fmt.Errorf("invalid pointer for %s: %s", spec, o)
+		}
+		q := o.Value
+		if err := checkRange(spec, q, 0, 63); err != nil {
+			return 0, 0, err
+		}
+		return uint16(q&0x07) | uint16(q&0x18)<<7 | uint16(q&0x20)<<8, 0, nil
+	}
+
+	// fixed pointer operands.
+	if o.Kind != OperandPointer || o.Pointer != spec || o.Value != 0 {
+		return 0, 0, fmt.Errorf("invalid operand for %s: %s", spec, o)
+	}
+	return 0, 0, nil
+}
+
+func (o *opcode) encode(addr uint16, operands []Operand) ([]uint16, error) {
+	specs := []string{}
+	if o.operands != "" {
+		specs = strings.Split(o.operands, ",")
+	}
+	if len(specs) != len(operands) {
+		return nil, fmt.Errorf("%s requires %d operands", o.mnemonic, len(specs))
+	}
+
+	op, next := o.value, uint16(0)
+	for i, spec := range specs {
+		v, n, err := encodeOperand(addr, spec, operands[i])
+		if err != nil {
+			return nil, err
+		}
+		op |= v
+		next |= n
+	}
+
+	if IsTwoWord(op) {
+		return []uint16{op, next}, nil
+	}
+	return []uint16{op}, nil
+}
+
+// Encode encodes the instruction located at addr (byte address), that is
+// only used by relative jumps and branches to absolute addresses. It returns
+// one word, or two for two-word instructions.
+func Encode(addr uint16, mnemonic string, operands ...Operand) ([]uint16, error) {
+	rv, err := encode(addr, mnemonic, operands)
+	if err != nil {
+		return nil, fmt.Errorf("avr: asm: %s", err)
+	}
+	return rv, nil
+}
+
+func encode(addr uint16, mnemonic string, operands []Operand) ([]uint16, error) {
+	mnemonic = strings.ToLower(mnemonic)
+
+	var rv error
+	for i := range opcodes {
+		o := &opcodes[i]
+		if o.mnemonic != mnemonic {
+			continue
+		}
+		words, err := o.encode(addr, operands)
+		if err == nil {
+			return words, nil
+		}
+		if rv == nil {
+			rv = err
+		}
+	}
+
+	if rv == nil {
+		rv = fmt.Errorf("unknown instruction: %s", mnemonic)
+	}
+	return nil, rv
+}
+
+// shortcuts for the instructions used most by dwtk.
+
 func ADIW(reg byte, val uint16) uint16 {
 	// opcode: 1001 0110 KKdd KKKK
 	op := uint16(0b1001011000000000)
@@ -58,3 +306,9 @@ func SPM() uint16 {
 	op := uint16(0b1001010111101000)
 	return op
 }
+
+// IsTwoWord reports if op is the first word of a two-word instruction: LDS,
+// STS, JMP or CALL.
+func IsTwoWord(op uint16) bool {
+	return op&0xfc0f == 0x9000 || op&0xfe0c == 0x940c
+}
@@ -0,0 +1,119 @@
+package avr
+
+import (
+	"testing"
+)
+
+func mustEncode(t *testing.T, mnemonic string, operands ...Operand) uint16 {
+	t.Helper()
+
+	words, err := Encode(0, mnemonic, operands...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return words[0]
+}
+
+func equal(a []uint16, b []uint16) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// every valid instruction word is decoded and encoded back, from its
+// operands and from its assembly.
+func TestRoundTrip(t *testing.T) {
+	const (
+		addr = 0x0100
+		next = 0x1234
+	)
+
+	decoded := make([]bool, len(opcodes))
+	for op := 0; op <= 0xffff; op++ {
+		inst := Decode(addr, uint16(op), next, FeaturesAll)
+		if !inst.Valid {
+			continue
+		}
+		for i, o := range opcodes {
+			if uint16(op)&o.mask == o.value {
+				decoded[i] = true
+				break
+			}
+		}
+
+		exp := []uint16{uint16(op)}
+		if IsTwoWord(uint16(op)) {
+			exp = append(exp, next)
+		}
+
+		words, err := Encode(addr, inst.Mnemonic, inst.Operands...)
+		if err != nil {
+			t.Fatalf("0x%04x: %s: %s", op, inst, err)
+		}
+		if !equal(words, exp) {
+			t.Fatalf("0x%04x: %s: encoded as %04x", op, inst, words)
+		}
+
+		words, err = Assemble(addr, inst.String(), nil)
+		if err != nil {
+			t.Fatalf("0x%04x: %s: %s", op, inst, err)
+		}
+		if !equal(words, exp) {
+			t.Fatalf("0x%04x: %s: assembled as %04x", op, inst, words)
+		}
+	}
+
+	for i, o := range opcodes {
+		if !decoded[i] {
+			t.Errorf("%s (0x%04x): opcode form never decoded", o.mnemonic, o.value)
+		}
+	}
+}
+
+func TestShortcuts(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		got  uint16
+		exp  uint16
+	}{
+		{"adiw", ADIW(30, 2), mustEncode(t, "adiw", Reg(30), Imm(2))},
+		{"adiw", ADIW(24, 63), mustEncode(t, "adiw", Reg(24), Imm(63))},
+		{"break", BREAK(), mustEncode(t, "break")},
+		{"in", IN(0x37, 29), mustEncode(t, "in", Reg(29), IO(0x37))},
+		{"out", OUT(0x3f, 0), mustEncode(t, "out", IO(0x3f), Reg(0))},
+		{"lpm", LPM(28, true), mustEncode(t, "lpm", Reg(28), Ptr("Z+"))},
+		{"lpm", LPM(0, false), mustEncode(t, "lpm", Reg(0), Ptr("Z"))},
+		{"spm", SPM(), mustEncode(t, "spm")},
+	} {
+		if tc.got != tc.exp {
+			t.Errorf("%s: 0x%04x != 0x%04x", tc.name, tc.got, tc.exp)
+		}
+	}
+}
+
+func TestEncodeErrors(t *testing.T) {
+	for _, tc := range []struct {
+		mnemonic string
+		operands []Operand
+	}{
+		{"ldi", []Operand{Reg(15), Imm(1)}},
+		{"adiw", []Operand{Reg(25), Imm(1)}},
+		{"adiw", []Operand{Reg(24), Imm(64)}},
+		{"in", []Operand{Reg(0), IO(64)}},
+		{"movw", []Operand{Reg(1), Reg(2)}},
+		{"rjmp", []Operand{Rel(3)}},
+		{"brne", []Operand{Rel(128)}},
+		{"nop", []Operand{Reg(0)}},
+		{"foo", nil},
+	} {
+		if _, err := Encode(0, tc.mnemonic, tc.operands...); err == nil {
+			t.Errorf("%s %v: error not reported", tc.mnemonic, tc.operands)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package avr
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// aliases supported by the assembler, as a function returning the mnemonic
+// and operands of the actual instruction.
+var aliases = map[string]func(ops []Operand) (string, []Operand){
+	"clr":  func(ops []Operand) (string, []Operand) { return "eor", append(ops, ops...) },
+	"lsl":  func(ops []Operand) (string, []Operand) { return "add", append(ops, ops...) },
+	"rol":  func(ops []Operand) (string, []Operand) { return "adc", append(ops, ops...) },
+	"tst":  func(ops []Operand) (string, []Operand) { return "and", append(ops, ops...) },
+	"ser":  func(ops []Operand) (string, []Operand) { return "ldi", append(ops, Imm(0xff)) },
+	"brlo": func(ops []Operand) (string, []Operand) { return "brcs", ops },
+	"brsh": func(ops []Operand) (string, []Operand) { return "brcc", ops },
+}
+
+var (
+	reRegister = regexp.MustCompile(`^[rR]([0-9]+)$`)
+	rePointer  = regexp.MustCompile(`^(-?[XYZ]\+?)$`)
+	reDisp     = regexp.MustCompile(`^([YZ])\+(.+)$`)
+	reRelative = regexp.MustCompile(`^\.([+-][0-9]+)$`)
+)
+
+type statement struct {
+	line     int
+	addr     uint16
+	mnemonic string
+	operands []string
+}
+
+func (s *statement) size() uint16 {
+	switch s.mnemonic {
+	case "lds", "sts", "jmp", "call":
+		return 4
+	}
+	return 2
+}
+
+// parseValue parses a number or a symbol, optionally followed by an offset,
+// e.g. "0x20", "-1", "EECR" or "loop+2". Labels are program addresses.
+func parseValue(s string, symbols map[string]int, labels map[string]uint16) (Operand, error) {
+	if v, err := strconv.ParseInt(s, 0, 32); err == nil {
+		return Imm(int(v)), nil
+	}
+
+	name, off := s, 0
+	if i := strings.LastIndexAny(s, "+-"); i > 0 {
+		v, err := strconv.ParseInt(s[i:], 0, 32)
+		if err != nil {
+			return Operand{}, fmt.Errorf("invalid offset: %s", s)
+		}
+		name, off = strings.TrimSpace(s[:i]), int(v)
+	}
+	if v, ok := labels[name]; ok {
+		return Abs(int(v) + off), nil
+	}
+	if v, ok := symbols[name]; ok {
+		return Imm(v + off), nil
+	}
+	return Operand{}, fmt.Errorf("undefined symbol: %s", name)
+}
+
+func parseOperand(s string, symbols map[string]int, labels map[string]uint16) (Operand, error) {
+	if m := reRegister.FindStringSubmatch(s); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		return Reg(n), nil
+	}
+	if m := rePointer.FindStringSubmatch(strings.ToUpper(s)); m != nil {
+		return Ptr(m[1]), nil
+	}
+	if m := reDisp.FindStringSubmatch(s); m != nil {
+		q, err := parseValue(m[2], symbols, labels)
+		if err != nil {
+			return Operand{}, err
+		}
+		return Disp(m[1], q.Value), nil
+	}
+	if m := reRelative.FindStringSubmatch(s); m != nil {
+		v, _ := strconv.Atoi(m[1])
+		return Rel(v), nil
+	}
+	return parseValue(s, symbols, labels)
+}
+
+// Assemble assembles src, to be located at addr (byte address). src has one
+// instruction per line, with optional labels ("loop:") and comments (after
+// ";"). Operands are written as in the AVR instruction set manual, and
+// numbers may be replaced by names from symbols.
+func Assemble(addr uint16, src string, symbols map[string]int) ([]uint16, error) {
+	stmts := []*statement{}
+	labels := make(map[string]uint16)
+
+	for i, line := range strings.Split(src, "\n") {
+		if c := strings.Index(line, ";"); c >= 0 {
+			line = line[:c]
+		}
+		line = strings.TrimSpace(line)
+
+		if c := strings.Index(line, ":"); c >= 0 {
+			label := strings.TrimSpace(line[:c])
+			if _, ok := labels[label]; ok {
+				return nil, fmt.Errorf("avr: asm: line %d: duplicated label: %s", i+1, label)
+			}
+			labels[label] = addr
+			line = strings.TrimSpace(line[c+1:])
+		}
+		if line == "" {
+			continue
+		}
+
+		s := &statement{
+			line: i + 1,
+			addr: addr,
+		}
+		s.mnemonic = strings.ToLower(line)
+		if c := strings.IndexAny(line, " \t"); c >= 0 {
+			s.mnemonic = strings.ToLower(line[:c])
+			for _, o := range strings.Split(line[c+1:], ",") {
+				s.operands = append(s.operands, strings.TrimSpace(o))
+			}
+		}
+		stmts = append(stmts, s)
+		addr += s.size()
+	}
+
+	rv := []uint16{}
+	for _, s := range stmts {
+		ops := []Operand{}
+		for _, o := range s.operands {
+			op, err := parseOperand(o, symbols, labels)
+			if err != nil {
+				return nil, fmt.Errorf("avr: asm: line %d: %s", s.line, err)
+			}
+			ops = append(ops, op)
+		}
+
+		mnemonic := s.mnemonic
+		if alias, ok := aliases[mnemonic]; ok {
+			mnemonic, ops = alias(ops)
+		}
+
+		words, err := encode(s.addr, mnemonic, ops)
+		if err != nil {
+			return nil, fmt.Errorf("avr: asm: line %d: %s", s.line, err)
+		}
+		rv = append(rv, words...)
+	}
+
+	return rv, nil
+}
@@ -0,0 +1,448 @@
+package avr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Features are the optional parts of the AVR instruction set, that depend on
+// the core of the device.
+type Features uint16
+
+const (
+	FeatureMUL   Features = 1 << iota // MUL, MULS, MULSU, FMUL, FMULS, FMULSU
+	FeatureJMP                        // JMP, CALL
+	FeatureMOVW                       // MOVW
+	FeatureLPMX                       // LPM Rd, Z and LPM Rd, Z+
+	FeatureSPM                        // SPM
+	FeatureSPMX                       // SPM Z+
+	FeatureBREAK                      // BREAK
+	FeatureELPM                       // ELPM
+	FeatureEIJMP                      // EIJMP, EICALL
+	FeatureDES                        // DES
+	FeatureRMW                        // XCH, LAS, LAC, LAT
+)
+
+const (
+	FeaturesAVRe     = FeatureMOVW | FeatureLPMX | FeatureSPM | FeatureBREAK
+	FeaturesAVReplus = FeaturesAVRe | FeatureMUL
+	FeaturesAVRxt    = FeaturesAVReplus | FeatureSPMX
+	FeaturesAll      = ^Features(0)
+)
+
+// Flow describes how an instruction changes the program flow.
+type Flow int
+
+const (
+	FlowNext   Flow = iota // continues to the following instruction
+	FlowSkip               // may skip the following instruction
+	FlowBranch             // may branch to a relative address
+	FlowJump               // jumps to an absolute, relative or indirect address
+	FlowCall               // calls an absolute, relative or indirect address
+	FlowReturn             // returns from a call or interrupt
+)
+
+type OperandKind int
+
+const (
+	OperandRegister  OperandKind = iota // r0-r31
+	OperandImmediate                    // constant
+	OperandIO                           // I/O address
+	OperandData                         // data space address
+	OperandBit                          // bit number
+	OperandRelative                     // program offset, in bytes
+	OperandAbsolute                     // program address, in bytes
+	OperandPointer                      // X, Y or Z, with pre/post increment or displacement
+)
+
+type Operand struct {
+	Kind  OperandKind
+	Value int
+
+	// Pointer is the pointer register as written in assembly ("X+", "-Y",
+	// "Z+"...), for pointer operands. Value is the displacement.
+	Pointer string
+}
+
+type Instruction struct {
+	Address  uint16 // byte address
+	Op       uint16
+	Arg      uint16 // second word of two-word instructions
+	Mnemonic string
+	Operands []Operand
+	Flow     Flow
+	Features Features // required by the instruction
+	Valid    bool     // false for words that aren't valid instructions
+}
+
+type opcode struct {
+	mnemonic string
+	mask     uint16
+	value    uint16
+	operands string
+	flow     Flow
+	features Features
+}
+
+// the order matters: aliases and special cases come before the generic
+// encodings that they overlap with.
+var opcodes = []opcode{
+	{"nop", 0xffff, 0x0000, "", FlowNext, 0},
+	{"movw", 0xff00, 0x0100, "Rdw,Rrw", FlowNext, FeatureMOVW},
+	{"muls", 0xff00, 0x0200, "Rdh,Rrh", FlowNext, FeatureMUL},
+	{"mulsu", 0xff88, 0x0300, "Rd3,Rr3", FlowNext, FeatureMUL},
+	{"fmul", 0xff88, 0x0308, "Rd3,Rr3", FlowNext, FeatureMUL},
+	{"fmuls", 0xff88, 0x0380, "Rd3,Rr3", FlowNext, FeatureMUL},
+	{"fmulsu", 0xff88, 0x0388, "Rd3,Rr3", FlowNext, FeatureMUL},
+	{"cpc", 0xfc00, 0x0400, "Rd,Rr", FlowNext, 0},
+	{"sbc", 0xfc00, 0x0800, "Rd,Rr", FlowNext, 0},
+	{"add", 0xfc00, 0x0c00, "Rd,Rr", FlowNext, 0},
+	{"cpse", 0xfc00, 0x1000, "Rd,Rr", FlowSkip, 0},
+	{"cp", 0xfc00, 0x1400, "Rd,Rr", FlowNext, 0},
+	{"sub", 0xfc00, 0x1800, "Rd,Rr", FlowNext, 0},
+	{"adc", 0xfc00, 0x1c00, "Rd,Rr", FlowNext, 0},
+	{"and", 0xfc00, 0x2000, "Rd,Rr", FlowNext, 0},
+	{"eor", 0xfc00, 0x2400, "Rd,Rr", FlowNext, 0},
+	{"or", 0xfc00, 0x2800, "Rd,Rr", FlowNext, 0},
+	{"mov", 0xfc00, 0x2c00, "Rd,Rr", FlowNext, 0},
+	{"cpi", 0xf000, 0x3000, "Rdh,K8", FlowNext, 0},
+	{"sbci", 0xf000, 0x4000, "Rdh,K8", FlowNext, 0},
+	{"subi", 0xf000, 0x5000, "Rdh,K8", FlowNext, 0},
+	{"ori", 0xf000, 0x6000, "Rdh,K8", FlowNext, 0},
+	{"andi", 0xf000, 0x7000, "Rdh,K8", FlowNext, 0},
+	{"ld", 0xfe0f, 0x8000, "Rd,Z", FlowNext, 0},
+	{"ld", 0xfe0f, 0x8008, "Rd,Y", FlowNext, 0},
+	{"ldd", 0xd208, 0x8000, "Rd,Z+q", FlowNext, 0},
+	{"ldd", 0xd208, 0x8008, "Rd,Y+q", FlowNext, 0},
+	{"st", 0xfe0f, 0x8200, "Z,Rd", FlowNext, 0},
+	{"st", 0xfe0f, 0x8208, "Y,Rd", FlowNext, 0},
+	{"std", 0xd208, 0x8200, "Z+q,Rd", FlowNext, 0},
+	{"std", 0xd208, 0x8208, "Y+q,Rd", FlowNext, 0},
+	{"lds", 0xfe0f, 0x9000, "Rd,k16", FlowNext, 0},
+	{"ld", 0xfe0f, 0x9001, "Rd,Z+", FlowNext, 0},
+	{"ld", 0xfe0f, 0x9002, "Rd,-Z", FlowNext, 0},
+	{"lpm", 0xfe0f, 0x9004, "Rd,Z", FlowNext, FeatureLPMX},
+	{"lpm", 0xfe0f, 0x9005, "Rd,Z+", FlowNext, FeatureLPMX},
+	{"elpm", 0xfe0f, 0x9006, "Rd,Z", FlowNext, FeatureELPM},
+	{"elpm", 0xfe0f, 0x9007, "Rd,Z+", FlowNext, FeatureELPM},
+	{"ld", 0xfe0f, 0x9009, "Rd,Y+", FlowNext, 0},
+	{"ld", 0xfe0f, 0x900a, "Rd,-Y", FlowNext, 0},
+	{"ld", 0xfe0f, 0x900c, "Rd,X", FlowNext, 0},
+	{"ld", 0xfe0f, 0x900d, "Rd,X+", FlowNext, 0},
+	{"ld", 0xfe0f, 0x900e, "Rd,-X", FlowNext, 0},
+	{"pop", 0xfe0f, 0x900f, "Rd", FlowNext, 0},
+	{"sts", 0xfe0f, 0x9200, "k16,Rd", FlowNext, 0},
+	{"st", 0xfe0f, 0x9201, "Z+,Rd", FlowNext, 0},
+	{"st", 0xfe0f, 0x9202, "-Z,Rd", FlowNext, 0},
+	{"xch", 0xfe0f, 0x9204, "Z,Rd", FlowNext, FeatureRMW},
+	{"las", 0xfe0f, 0x9205, "Z,Rd", FlowNext, FeatureRMW},
+	{"lac", 0xfe0f, 0x9206, "Z,Rd", FlowNext, FeatureRMW},
+	{"lat", 0xfe0f, 0x9207, "Z,Rd", FlowNext, FeatureRMW},
+	{"st", 0xfe0f, 0x9209, "Y+,Rd", FlowNext, 0},
+	{"st", 0xfe0f, 0x920a, "-Y,Rd", FlowNext, 0},
+	{"st", 0xfe0f, 0x920c, "X,Rd", FlowNext, 0},
+	{"st", 0xfe0f, 0x920d, "X+,Rd", FlowNext, 0},
+	{"st", 0xfe0f, 0x920e, "-X,Rd", FlowNext, 0},
+	{"push", 0xfe0f, 0x920f, "Rd", FlowNext, 0},
+	{"com", 0xfe0f, 0x9400, "Rd", FlowNext, 0},
+	{"neg", 0xfe0f, 0x9401, "Rd", FlowNext, 0},
+	{"swap", 0xfe0f, 0x9402, "Rd", FlowNext, 0},
+	{"inc", 0xfe0f, 0x9403, "Rd", FlowNext, 0},
+	{"asr", 0xfe0f, 0x9405, "Rd", FlowNext, 0},
+	{"lsr", 0xfe0f, 0x9406, "Rd", FlowNext, 0},
+	{"ror", 0xfe0f, 0x9407, "Rd", FlowNext, 0},
+	{"sec", 0xffff, 0x9408, "", FlowNext, 0},
+	{"sez", 0xffff, 0x9418, "", FlowNext, 0},
+	{"sen", 0xffff, 0x9428, "", FlowNext, 0},
+	{"sev", 0xffff, 0x9438, "", FlowNext, 0},
+	{"ses", 0xffff, 0x9448, "", FlowNext, 0},
+	{"seh", 0xffff, 0x9458, "", FlowNext, 0},
+	{"set", 0xffff, 0x9468, "", FlowNext, 0},
+	{"sei", 0xffff, 0x9478, "", FlowNext, 0},
+	{"clc", 0xffff, 0x9488, "", FlowNext, 0},
+	{"clz", 0xffff, 0x9498, "", FlowNext, 0},
+	{"cln", 0xffff, 0x94a8, "", FlowNext, 0},
+	{"clv", 0xffff, 0x94b8, "", FlowNext, 0},
+	{"cls", 0xffff, 0x94c8, "", FlowNext, 0},
+	{"clh", 0xffff, 0x94d8, "", FlowNext, 0},
+	{"clt", 0xffff, 0x94e8, "", FlowNext, 0},
+	{"cli", 0xffff, 0x94f8, "", FlowNext, 0},
+	{"ijmp", 0xffff, 0x9409, "", FlowJump, 0},
+	{"eijmp", 0xffff, 0x9419, "", FlowJump, FeatureEIJMP},
+	{"dec", 0xfe0f, 0x940a, "Rd", FlowNext, 0},
+	{"des", 0xff0f, 0x940b, "K4", FlowNext, FeatureDES},
+	{"jmp", 0xfe0e, 0x940c, "k22", FlowJump, FeatureJMP},
+	{"call", 0xfe0e, 0x940e, "k22", FlowCall, FeatureJMP},
+	{"ret", 0xffff, 0x9508, "", FlowReturn, 0},
+	{"reti", 0xffff, 0x9518, "", FlowReturn, 0},
+	{"sleep", 0xffff, 0x9588, "", FlowNext, 0},
+	{"break", 0xffff, 0x9598, "", FlowNext, FeatureBREAK},
+	{"wdr", 0xffff, 0x95a8, "", FlowNext, 0},
+	{"lpm", 0xffff, 0x95c8, "", FlowNext, 0},
+	{"elpm", 0xffff, 0x95d8, "", FlowNext, FeatureELPM},
+	{"spm", 0xffff, 0x95e8, "", FlowNext, FeatureSPM},
+	{"spm", 0xffff, 0x95f8, "Z+", FlowNext, FeatureSPMX},
+	{"icall", 0xffff, 0x9509, "", FlowCall, 0},
+	{"eicall", 0xffff, 0x9519, "", FlowCall, FeatureEIJMP},
+	{"adiw", 0xff00, 0x9600, "Rdiw,K6", FlowNext, 0},
+	{"sbiw", 0xff00, 0x9700, "Rdiw,K6", FlowNext, 0},
+	{"cbi", 0xff00, 0x9800, "A5,b", FlowNext, 0},
+	{"sbic", 0xff00, 0x9900, "A5,b", FlowSkip, 0},
+	{"sbi", 0xff00, 0x9a00, "A5,b", FlowNext, 0},
+	{"sbis", 0xff00, 0x9b00, "A5,b", FlowSkip, 0},
+	{"mul", 0xfc00, 0x9c00, "Rd,Rr", FlowNext, FeatureMUL},
+	{"in", 0xf800, 0xb000, "Rd,A6", FlowNext, 0},
+	{"out", 0xf800, 0xb800, "A6,Rd", FlowNext, 0},
+	{"rjmp", 0xf000, 0xc000, "k12", FlowJump, 0},
+	{"rcall", 0xf000, 0xd000, "k12", FlowCall, 0},
+	{"ldi", 0xf000, 0xe000, "Rdh,K8", FlowNext, 0},
+	{"brcs", 0xfc07, 0xf000, "k7", FlowBranch, 0},
+	{"breq", 0xfc07, 0xf001, "k7", FlowBranch, 0},
+	{"brmi", 0xfc07, 0xf002, "k7", FlowBranch, 0},
+	{"brvs", 0xfc07, 0xf003, "k7", FlowBranch, 0},
+	{"brlt", 0xfc07, 0xf004, "k7", FlowBranch, 0},
+	{"brhs", 0xfc07, 0xf005, "k7", FlowBranch, 0},
+	{"brts", 0xfc07, 0xf006, "k7", FlowBranch, 0},
+	{"brie", 0xfc07, 0xf007, "k7", FlowBranch, 0},
+	{"brcc", 0xfc07, 0xf400, "k7", FlowBranch, 0},
+	{"brne", 0xfc07, 0xf401, "k7", FlowBranch, 0},
+	{"brpl", 0xfc07, 0xf402, "k7", FlowBranch, 0},
+	{"brvc", 0xfc07, 0xf403, "k7", FlowBranch, 0},
+	{"brge", 0xfc07, 0xf404, "k7", FlowBranch, 0},
+	{"brhc", 0xfc07, 0xf405, "k7", FlowBranch, 0},
+	{"brtc", 0xfc07, 0xf406, "k7", FlowBranch, 0},
+	{"brid", 0xfc07, 0xf407, "k7", FlowBranch, 0},
+	{"bld", 0xfe08, 0xf800, "Rd,b", FlowNext, 0},
+	{"bst", 0xfe08, 0xfa00, "Rd,b", FlowNext, 0},
+	{"sbrc", 0xfe08, 0xfc00, "Rd,b", FlowSkip, 0},
+	{"sbrs", 0xfe08, 0xfe00, "Rd,b", FlowSkip, 0},
+}
+
+func operand(op uint16, next uint16, spec string) Operand {
+	d := int((op >> 4) & 0x1f)
+	r := int((op & 0x0f) | ((op >> 5) & 0x10))
+
+	switch spec {
+	case "Rd":
+		return Operand{Kind: OperandRegister, Value: d}
+	case "Rr":
+		return Operand{Kind: OperandRegister, Value: r}
+	case "Rdh":
+		return Operand{Kind: OperandRegister, Value: 16 + int((op>>4)&0x0f)}
+	case "Rrh":
+		return Operand{Kind: OperandRegister, Value: 16 + int(op&0x0f)}
+	case "Rd3":
+		return Operand{Kind: OperandRegister, Value: 16 + int((op>>4)&0x07)}
+	case "Rr3":
+		return Operand{Kind: OperandRegister, Value: 16 + int(op&0x07)}
+	case "Rdw":
+		return Operand{Kind: OperandRegister, Value: 2 * int((op>>4)&0x0f)}
+	case "Rrw":
+		return Operand{Kind: OperandRegister, Value: 2 * int(op&0x0f)}
+	case "Rdiw":
+		return Operand{Kind: OperandRegister, Value: 24 + 2*int((op>>4)&0x03)}
+	case "K4":
+		return Operand{Kind: OperandImmediate, Value: int((op >> 4) & 0x0f)}
+	case "K6":
+		return Operand{Kind: OperandImmediate, Value: int((op & 0x0f) | ((op >> 2) & 0x30))}
+	case "K8":
+		return Operand{Kind: OperandImmediate, Value: int((op & 0x0f) | ((op >> 4) & 0xf0))}
+	case "A5":
+		return Operand{Kind: OperandIO, Value: int((op >> 3) & 0x1f)}
+	case "A6":
+		return Operand{Kind: OperandIO, Value: int((op & 0x0f) | ((op >> 5) & 0x30))}
+	case "b":
+		return Operand{Kind: OperandBit, Value: int(op & 0x07)}
+	case "k7":
+		return Operand{Kind: OperandRelative, Value: 2 * int(int16(op<<6)>>9)}
+	case "k12":
+		return Operand{Kind: OperandRelative, Value: 2 * int(int16(op<<4)>>4)}
+	case "k16":
+		return Operand{Kind: OperandData, Value: int(next)}
+	case "k22":
+		return Operand{Kind: OperandAbsolute, Value: 2 * ((int((op>>3)&0x3e)|int(op&0x01))<<16 | int(next))}
+	case "Y+q", "Z+q":
+		q := int((op & 0x07) | ((op >> 7) & 0x18) | ((op >> 8) & 0x20))
+		return Operand{Kind: OperandPointer, Value: q, Pointer: spec[:1]}
+	}
+	return Operand{Kind: OperandPointer, Pointer: spec}
+}
+
+// Decode decodes the instruction op located at addr (byte address). next is
+// the following word, used by two-word instructions. Instructions that
+// require features not available are decoded as invalid.
+func Decode(addr uint16, op uint16, next uint16, features Features) *Instruction {
+	for _, o := range opcodes {
+		if op&o.mask != o.value {
+			continue
+		}
+		if o.features&^features != 0 {
+			break
+		}
+
+		rv := &Instruction{
+			Address:  addr,
+			Op:       op,
+			Mnemonic: o.mnemonic,
+			Flow:     o.flow,
+			Features: o.features,
+			Valid:    true,
+		}
+		if IsTwoWord(op) {
+			rv.Arg = next
+		}
+		if o.operands != "" {
+			for _, spec := range strings.Split(o.operands, ",") {
+				rv.Operands = append(rv.Operands, operand(op, next, spec))
+			}
+		}
+		return rv
+	}
+
+	return invalid(addr, op)
+}
+
+func invalid(addr uint16, op uint16) *Instruction {
+	return &Instruction{
+		Address:  addr,
+		Op:       op,
+		Mnemonic: ".word",
+		Operands: []Operand{{Kind: OperandImmediate, Value: int(op)}},
+		Flow:     FlowNext,
+	}
+}
+
+// Disassemble decodes the instructions in data, that starts at addr.
+func Disassemble(addr uint16, data []byte, features Features) []*Instruction {
+	words := make([]uint16, len(data)/2)
+	for i := range words {
+		words[i] = (uint16(data[2*i+1]) << 8) | uint16(data[2*i])
+	}
+
+	rv := []*Instruction{}
+	for i := 0; i < len(words); {
+		next := uint16(0)
+		if i+1 < len(words) {
+			next = words[i+1]
+		}
+		inst := Decode(addr+uint16(2*i), words[i], next, features)
+		if IsTwoWord(inst.Op) && i+1 >= len(words) {
+			// the second word is out of range.
+			inst = invalid(inst.Address, inst.Op)
+		}
+		rv = append(rv, inst)
+		i += inst.Size() / 2
+	}
+	return rv
+}
+
+// Size returns the size of the instruction, in bytes.
+func (i *Instruction) Size() int {
+	if i.Valid && IsTwoWord(i.Op) {
+		return 4
+	}
+	return 2
+}
+
+// Bytes returns the instruction as stored in flash.
+func (i *Instruction) Bytes() []byte {
+	rv := []byte{byte(i.Op), byte(i.Op >> 8)}
+	if i.Size() == 4 {
+		rv = append(rv, byte(i.Arg), byte(i.Arg>>8))
+	}
+	return rv
+}
+
+// Next returns the address of the following instruction.
+func (i *Instruction) Next() uint16 {
+	return i.Address + uint16(i.Size())
+}
+
+// Target returns the destination of jumps, calls and branches, if it doesn't
+// depend on registers.
+func (i *Instruction) Target() (uint16, bool) {
+	if i.Flow != FlowJump && i.Flow != FlowCall && i.Flow != FlowBranch {
+		return 0, false
+	}
+	for _, o := range i.Operands {
+		switch o.Kind {
+		case OperandRelative:
+			return i.Address + 2 + uint16(o.Value), true
+		case OperandAbsolute:
+			return uint16(o.Value), true
+		}
+	}
+	return 0, false
+}
+
+func (o Operand) String() string {
+	switch o.Kind {
+	case OperandRegister:
+		return fmt.Sprintf("r%d", o.Value)
+	case OperandImmediate:
+		if o.Value > 0xff {
+			return fmt.Sprintf("0x%04x", o.Value)
+		}
+		return fmt.Sprintf("0x%02x", o.Value)
+	case OperandIO:
+		return fmt.Sprintf("0x%02x", o.Value)
+	case OperandData, OperandAbsolute:
+		return fmt.Sprintf("0x%04x", o.Value)
+	case OperandBit:
+		return fmt.Sprintf("%d", o.Value)
+	case OperandRelative:
+		return fmt.Sprintf(".%+d", o.Value)
+	case OperandPointer:
+		if o.Value != 0 {
+			return fmt.Sprintf("%s+%d", o.Pointer, o.Value)
+		}
+		return o.Pointer
+	}
+	return "?"
+}
+
+func (i *Instruction) String() string {
+	if len(i.Operands) == 0 {
+		return i.Mnemonic
+	}
+	ops := make([]string, len(i.Operands))
+	for j, o := range i.Operands {
+		ops[j] = o.String()
+	}
+	return i.Mnemonic + " " + strings.Join(ops, ", ")
+}
+
+// Symbols maps program addresses (in bytes) to symbol names.
+type Symbols map[uint16]string
+
+// Lookup returns the name of the symbol that contains addr, with an offset
+// if addr isn't the start of the symbol, e.g. "main+0x4".
+func (s Symbols) Lookup(addr uint16) (string, bool) {
+	best, found := uint16(0), false
+	for a := range s {
+		if a <= addr && (!found || a > best) {
+			best, found = a, true
+		}
+	}
+	if !found {
+		return "", false
+	}
+	if best == addr {
+		return s[best], true
+	}
+	return fmt.Sprintf("%s+0x%x", s[best], addr-best), true
+}
+
+// Comment returns an annotation for the instruction, with the destination of
+// jumps, calls and branches, and its symbol, if any.
+func (i *Instruction) Comment(symbols Symbols) string {
+	t, ok := i.Target()
+	if !ok {
+		return ""
+	}
+	rv := fmt.Sprintf("0x%04x", t)
+	if name, ok := symbols.Lookup(t); ok {
+		rv += " <" + name + ">"
+	}
+	return rv
+}
@@ -47,3 +47,33 @@ func SpiWriteEFuse(b byte) []byte {
 func SpiWriteLock(b byte) []byte {
 	return []byte{0xac, 0xe0, 0x00, b}
 }
+
+// program memory is addressed by words on SPI ISP.
+
+func SpiReadProgramMemoryLow(addr uint16) []byte {
+	return []byte{0x20, byte(addr >> 8), byte(addr), 0x00}
+}
+
+func SpiReadProgramMemoryHigh(addr uint16) []byte {
+	return []byte{0x28, byte(addr >> 8), byte(addr), 0x00}
+}
+
+func SpiLoadProgramMemoryPageLow(addr uint16, b byte) []byte {
+	return []byte{0x40, byte(addr >> 8), byte(addr), b}
+}
+
+func SpiLoadProgramMemoryPageHigh(addr uint16, b byte) []byte {
+	return []byte{0x48, byte(addr >> 8), byte(addr), b}
+}
+
+func SpiWriteProgramMemoryPage(addr uint16) []byte {
+	return []byte{0x4c, byte(addr >> 8), byte(addr), 0x00}
+}
+
+func SpiReadEEPROM(addr uint16) []byte {
+	return []byte{0xa0, byte(addr >> 8), byte(addr), 0x00}
+}
+
+func SpiWriteEEPROM(addr uint16, b byte) []byte {
+	return []byte{0xc0, byte(addr >> 8), byte(addr), b}
+}
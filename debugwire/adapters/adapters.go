@@ -5,18 +5,34 @@ import (
 	"fmt"
 
 	"github.com/dwtk/devices"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 	"github.com/dwtk/dwtk/debugwire/adapters/dwtkice"
+	"github.com/dwtk/dwtk/debugwire/adapters/sim"
+	"github.com/dwtk/dwtk/debugwire/adapters/stk500"
+	"github.com/dwtk/dwtk/debugwire/adapters/stk500v2"
+	"github.com/dwtk/dwtk/debugwire/adapters/updi"
 	"github.com/dwtk/dwtk/debugwire/adapters/usbserial"
 )
 
 type Adapter interface {
 	Close() error
 	Info() string
-	SetMCU(mcu *devices.MCU)
-	GetMCU() *devices.MCU
+
+	// ID identifies the adapter across sessions and firmware updates, by
+	// its type and serial number or port.
+	ID() string
+
+	Capabilities() common.Capabilities
+	SetMCU(mcu common.MCU)
+	GetMCU() common.MCU
 
 	Enable() error
 	Disable() error
+
+	// SwitchMode moves the target between debugWIRE and SPI ISP modes
+	// without changing the DWEN fuse, if the adapter has CapModeSwitch.
+	SwitchMode(debugWIRE bool) error
+
 	Reset() error
 	ReadSignature() (uint16, error)
 	ChipErase() error
@@ -45,6 +61,9 @@ type Adapter interface {
 	WriteFlashPage(start uint16, data []byte) error
 	EraseFlashPage(start uint16) error
 
+	ReadEEPROM(start uint16, data []byte) error
+	WriteEEPROM(start uint16, data []byte) error
+
 	ReadFuses() ([]byte, error)
 	WriteLFuse(data byte) error
 	WriteHFuse(data byte) error
@@ -52,21 +71,97 @@ type Adapter interface {
 	WriteLock(data byte) error
 }
 
-func New(dwtkIce string, serialPort string, baudrate uint32) (Adapter, error) {
-	if dwtkIce != "" || serialPort == "" {
-		adapter, err := dwtkice.New(dwtkIce)
+type Options struct {
+	DwtkIce    string
+	SerialPort string
+	Baudrate   uint32
+	Stk500     bool
+	Stk500v2   bool
+	Updi       bool
+	Sim        string
+	Remote     string
+	Record     string
+	Replay     string
+}
+
+// GetMCUBySignature looks up the target device in the debugWIRE devices, and
+// then in the UPDI devices.
+func GetMCUBySignature(signature uint16) (common.MCU, error) {
+	mcu, err := devices.GetBySignature(signature)
+	if err == nil {
+		return mcu, nil
+	}
+	if mcu, err := updi.GetBySignature(signature); err == nil {
+		return mcu, nil
+	}
+	return nil, err
+}
+
+func New(opts *Options) (Adapter, error) {
+	if opts.Replay != "" {
+		return NewReplayer(opts.Replay)
+	}
+
+	adapter, err := newAdapter(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Record != "" {
+		rv, err := NewRecorder(adapter, opts.Record)
+		if err != nil {
+			adapter.Close()
+			return nil, err
+		}
+		return rv, nil
+	}
+	return adapter, nil
+}
+
+func newAdapter(opts *Options) (Adapter, error) {
+	if opts.Remote != "" {
+		return NewRemote(opts.Remote)
+	}
+
+	if opts.Sim != "" {
+		return sim.New(opts.Sim)
+	}
+
+	if opts.Stk500 {
+		if opts.SerialPort == "" {
+			return nil, fmt.Errorf("debugwire: adapters: stk500 bootloader requires a serial port")
+		}
+		return stk500.New(opts.SerialPort, opts.Baudrate)
+	}
+
+	if opts.Stk500v2 {
+		if opts.SerialPort == "" {
+			return nil, fmt.Errorf("debugwire: adapters: stk500v2 programmer requires a serial port")
+		}
+		return stk500v2.New(opts.SerialPort, opts.Baudrate)
+	}
+
+	if opts.Updi {
+		if opts.SerialPort == "" {
+			return nil, fmt.Errorf("debugwire: adapters: updi requires a serial port")
+		}
+		return updi.New(opts.SerialPort, opts.Baudrate)
+	}
+
+	if opts.DwtkIce != "" || opts.SerialPort == "" {
+		adapter, err := dwtkice.New(opts.DwtkIce)
 		if err != nil {
 			return nil, err
 		}
 		if adapter != nil {
 			return adapter, nil
 		}
-		if dwtkIce != "" {
+		if opts.DwtkIce != "" {
 			return nil, fmt.Errorf("debugwire: adapters: dwtk-ice requested but no device found")
 		}
 	}
 
-	adapter, err := usbserial.New(serialPort, baudrate)
+	adapter, err := usbserial.New(opts.SerialPort, opts.Baudrate)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,80 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/dwtk/dwtk/avr"
+)
+
+// AsmSymbols returns the I/O registers used by the instruction sequences
+// executed in the target, to be used with avr.Assemble.
+func AsmSymbols(mcu MCU) map[string]int {
+	return map[string]int{
+		"EEARL":  int(mcu.EEAR().Io8()),
+		"EEARH":  int(mcu.EEAR().Io8()) + 1,
+		"EEDR":   int(mcu.EEDR().Io8()),
+		"EECR":   int(mcu.EECR().Io8()),
+		"SPMCSR": int(mcu.SPMCSR().Io8()),
+	}
+}
+
+// Snippet is an instruction sequence executed in the target. Snippets are
+// declared once, and assembled on first use for each MCU, as the addresses
+// of the I/O registers differ.
+type Snippet struct {
+	src   string
+	insts map[string][]uint16
+	mutex sync.Mutex
+}
+
+func NewSnippet(src string) *Snippet {
+	return &Snippet{
+		src:   src,
+		insts: map[string][]uint16{},
+	}
+}
+
+func (s *Snippet) assemble(mcu MCU) ([]uint16, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if insts, ok := s.insts[mcu.Name()]; ok {
+		return insts, nil
+	}
+
+	insts, err := avr.Assemble(0, s.src, AsmSymbols(mcu))
+	if err != nil {
+		return nil, err
+	}
+	for _, inst := range insts {
+		if avr.IsTwoWord(inst) {
+			return nil, fmt.Errorf("debugwire: two-word instructions can't be executed: 0x%04x", inst)
+		}
+	}
+	s.insts[mcu.Name()] = insts
+	return insts, nil
+}
+
+// Execute executes the snippets in the target, one instruction at a time,
+// with WriteInstruction.
+func Execute(c Common, snippets ...*Snippet) error {
+	mcu := c.GetMCU()
+	if mcu == nil {
+		return errors.New("debugwire: MCU not set")
+	}
+
+	for _, s := range snippets {
+		insts, err := s.assemble(mcu)
+		if err != nil {
+			return err
+		}
+		for _, inst := range insts {
+			if err := c.WriteInstruction(inst); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
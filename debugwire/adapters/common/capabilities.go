@@ -0,0 +1,73 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Capabilities uint16
+
+const (
+	CapDebugWIRE Capabilities = (1 << iota)
+	CapSpiIsp
+	CapFuseWrite
+	CapChipErase
+	CapNativeFuseRead
+	CapTimers
+
+	// the adapter can switch the target between debugWIRE and SPI ISP modes.
+	CapModeSwitch
+
+	// the adapter can read and write flash and EEPROM.
+	CapMemory
+)
+
+var capNames = []struct {
+	cap  Capabilities
+	name string
+}{
+	{CapDebugWIRE, "debugWIRE"},
+	{CapSpiIsp, "SPI ISP"},
+	{CapFuseWrite, "fuse write"},
+	{CapChipErase, "chip erase"},
+	{CapNativeFuseRead, "native fuse read"},
+	{CapTimers, "timers control"},
+	{CapModeSwitch, "mode switch"},
+	{CapMemory, "flash/EEPROM access"},
+}
+
+func (c Capabilities) Has(caps Capabilities) bool {
+	return c&caps == caps
+}
+
+func (c Capabilities) String() string {
+	names := []string{}
+	for _, n := range capNames {
+		if c&n.cap != 0 {
+			names = append(names, n.name)
+		}
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
+
+// Require returns an error explaining why the capabilities are missing, if
+// any of them is not available.
+func (c Capabilities) Require(caps Capabilities) error {
+	missing := caps &^ c
+	if missing == 0 {
+		return nil
+	}
+
+	reason := "not supported by adapter"
+	if c.Has(CapModeSwitch) {
+		if c.Has(CapDebugWIRE) && missing&(CapSpiIsp|CapFuseWrite|CapChipErase) != 0 {
+			reason = "target running on debugWIRE mode, try `dwtk disable` or `--auto-mode`"
+		} else if c.Has(CapSpiIsp) && missing&(CapDebugWIRE|CapTimers) != 0 {
+			reason = "target running on SPI ISP mode, try `dwtk enable` or `--auto-mode`"
+		}
+	}
+	return fmt.Errorf("debugwire: operation requires %s: %s", missing, reason)
+}
@@ -1,11 +1,14 @@
 package common
 
 import (
-	"errors"
-
 	"github.com/dwtk/dwtk/avr"
 )
 
+var readFuse = NewSnippet(`
+	out SPMCSR, r29
+	lpm r28, Z+
+`)
+
 func ReadFuses(c Common) ([]byte, error) {
 	b := []byte{
 		avr.RFLB | avr.SPMEN, // to set SPMCSR
@@ -23,16 +26,7 @@ func ReadFuses(c Common) ([]byte, error) {
 			return nil, err
 		}
 
-		mcu := c.GetMCU()
-		if mcu == nil {
-			return nil, errors.New("debugwire: MCU not set")
-		}
-
-		if err := c.WriteInstruction(avr.OUT(mcu.SPMCSR().Io8(), 29)); err != nil {
-			return nil, err
-		}
-
-		if err := c.WriteInstruction(avr.LPM(28, true)); err != nil {
+		if err := Execute(c, readFuse); err != nil {
 			return nil, err
 		}
 
@@ -0,0 +1,298 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type atdfValue struct {
+	Caption string `xml:"caption,attr"`
+	Value   string `xml:"value,attr"`
+}
+
+type atdfValueGroup struct {
+	Name   string      `xml:"name,attr"`
+	Values []atdfValue `xml:"value"`
+}
+
+type atdfBitfield struct {
+	Name    string `xml:"name,attr"`
+	Caption string `xml:"caption,attr"`
+	Mask    string `xml:"mask,attr"`
+	Values  string `xml:"values,attr"`
+}
+
+type atdfRegister struct {
+	Name      string         `xml:"name,attr"`
+	Caption   string         `xml:"caption,attr"`
+	Offset    string         `xml:"offset,attr"`
+	Size      string         `xml:"size,attr"`
+	Bitfields []atdfBitfield `xml:"bitfield"`
+}
+
+type atdfRegisterGroup struct {
+	Name      string         `xml:"name,attr"`
+	Registers []atdfRegister `xml:"register"`
+}
+
+type atdfModule struct {
+	Name           string              `xml:"name,attr"`
+	RegisterGroups []atdfRegisterGroup `xml:"register-group"`
+	ValueGroups    []atdfValueGroup    `xml:"value-group"`
+}
+
+type atdfInstanceRegisterGroup struct {
+	NameInModule string `xml:"name-in-module,attr"`
+	AddressSpace string `xml:"address-space,attr"`
+}
+
+type atdfInstance struct {
+	RegisterGroups []atdfInstanceRegisterGroup `xml:"register-group"`
+}
+
+type atdfPeripheralModule struct {
+	Name      string         `xml:"name,attr"`
+	Instances []atdfInstance `xml:"instance"`
+}
+
+type atdfInterface struct {
+	Type string `xml:"type,attr"`
+}
+
+type atdf struct {
+	Device struct {
+		Name        string                 `xml:"name,attr"`
+		Interfaces  []atdfInterface        `xml:"interfaces>interface"`
+		Peripherals []atdfPeripheralModule `xml:"peripherals>module"`
+	} `xml:"devices>device"`
+	Modules []atdfModule `xml:"modules>module"`
+}
+
+type value struct {
+	value   uint64
+	caption string
+}
+
+type bitfield struct {
+	name    string
+	caption string
+	mask    uint64
+	values  string
+}
+
+type register struct {
+	name          string
+	caption       string
+	address       uint64
+	size          uint64
+	fields        []bitfield
+	readSensitive bool
+}
+
+// registers that can't be read without disturbing the target, as the atdf
+// files don't describe it: serial data registers pop their receive buffers
+// and clear the flags read before them, the LIN data register moves its
+// buffer index, and the debugWIRE data register is used by the debugWIRE
+// communication itself.
+var readSensitive = regexp.MustCompile(`^(UDR[0-9]?|SPDR[0-9]?|TWDR[0-9]?|LINDAT|DWDR)$`)
+
+func parseUint(s string) uint64 {
+	v, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return v
+}
+
+func caption(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func varName(name string, suffix string) string {
+	return strings.ToLower(name) + suffix
+}
+
+func main() {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", "github.com/dwtk/devices").Output()
+	if err != nil {
+		log.Fatal(err)
+	}
+	files, err := filepath.Glob(filepath.Join(strings.TrimSpace(string(out)), "atdf", "*.atdf"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(files) == 0 {
+		log.Fatal("no atdf files found, run `go mod download github.com/dwtk/devices` first")
+	}
+	sort.Strings(files)
+
+	devices := []string{}
+	tables := map[string]string{}
+	tableNames := map[string]string{}
+	tableVars := []string{}
+	valueNames := map[string]string{}
+	valueVars := []string{}
+
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		doc := atdf{}
+		err = xml.NewDecoder(f).Decode(&doc)
+		f.Close()
+		if err != nil {
+			log.Fatalf("%s: %s", file, err)
+		}
+
+		dw := false
+		for _, i := range doc.Device.Interfaces {
+			if i.Type == "dw" {
+				dw = true
+			}
+		}
+		if !dw {
+			continue
+		}
+
+		groups := map[string]bool{}
+		for _, p := range doc.Device.Peripherals {
+			for _, i := range p.Instances {
+				for _, g := range i.RegisterGroups {
+					if g.AddressSpace == "data" {
+						groups[p.Name+"/"+g.NameInModule] = true
+					}
+				}
+			}
+		}
+
+		regs := []register{}
+		seen := map[string]bool{}
+		for _, m := range doc.Modules {
+			vgroups := map[string][]value{}
+			for _, vg := range m.ValueGroups {
+				for _, v := range vg.Values {
+					vgroups[vg.Name] = append(vgroups[vg.Name], value{parseUint(v.Value), caption(v.Caption)})
+				}
+			}
+
+			for _, g := range m.RegisterGroups {
+				if !groups[m.Name+"/"+g.Name] {
+					continue
+				}
+				for _, r := range g.Registers {
+					if seen[r.Name] {
+						continue
+					}
+					seen[r.Name] = true
+
+					reg := register{
+						name:          r.Name,
+						caption:       caption(r.Caption),
+						address:       parseUint(r.Offset),
+						size:          parseUint(r.Size),
+						readSensitive: readSensitive.MatchString(r.Name),
+					}
+					for _, b := range r.Bitfields {
+						field := bitfield{
+							name:    b.Name,
+							caption: caption(b.Caption),
+							mask:    parseUint(b.Mask),
+							values:  "nil",
+						}
+						if vg, ok := vgroups[b.Values]; ok && b.Values != "" {
+							buf := &bytes.Buffer{}
+							fmt.Fprintln(buf, "[]Value{")
+							for _, v := range vg {
+								fmt.Fprintf(buf, "{0x%02x, %q},\n", v.value, v.caption)
+							}
+							fmt.Fprint(buf, "}")
+							n, ok := valueNames[buf.String()]
+							if !ok {
+								n = fmt.Sprintf("values%d", len(valueVars))
+								valueNames[buf.String()] = n
+								valueVars = append(valueVars, fmt.Sprintf("%s = %s\n", n, buf))
+							}
+							field.values = n
+						}
+						reg.fields = append(reg.fields, field)
+					}
+					regs = append(regs, reg)
+				}
+			}
+		}
+
+		sort.SliceStable(regs, func(i, j int) bool {
+			return regs[i].address < regs[j].address
+		})
+
+		buf := &bytes.Buffer{}
+		fmt.Fprintln(buf, "[]*Register{")
+		for _, r := range regs {
+			if len(r.fields) == 0 {
+				fmt.Fprintf(buf, "{%q, 0x%02x, %d, %q, nil, %t},\n", r.name, r.address, r.size, r.caption, r.readSensitive)
+				continue
+			}
+			fmt.Fprintf(buf, "{%q, 0x%02x, %d, %q, []*Bitfield{\n", r.name, r.address, r.size, r.caption)
+			for _, b := range r.fields {
+				fmt.Fprintf(buf, "{%q, 0x%02x, %q, %s},\n", b.name, b.mask, b.caption, b.values)
+			}
+			fmt.Fprintf(buf, "}, %t},\n", r.readSensitive)
+		}
+		fmt.Fprint(buf, "}")
+
+		n, ok := tableNames[buf.String()]
+		if !ok {
+			n = varName(doc.Device.Name, "Registers")
+			tableNames[buf.String()] = n
+			tableVars = append(tableVars, fmt.Sprintf("%s = %s\n", n, buf))
+		}
+		devices = append(devices, doc.Device.Name)
+		tables[doc.Device.Name] = n
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintln(buf, "// Code generated by generate.go; DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package common")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "var registerTables = map[string][]*Register{")
+	for _, d := range devices {
+		fmt.Fprintf(buf, "%q: %s,\n", d, tables[d])
+	}
+	fmt.Fprintln(buf, "}")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "var (")
+	for _, v := range tableVars {
+		fmt.Fprint(buf, v)
+	}
+	fmt.Fprintln(buf, ")")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "var (")
+	for _, v := range valueVars {
+		fmt.Fprint(buf, v)
+	}
+	fmt.Fprintln(buf, ")")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("registers_table.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,87 @@
+package common
+
+import (
+	"github.com/dwtk/dwtk/avr"
+)
+
+// instruction sets of the avr-gcc architectures of the debugWIRE devices.
+const (
+	avr25 = avr.FeaturesAVRe
+	avr35 = avr25 | avr.FeatureJMP
+	avr4  = avr.FeaturesAVReplus
+	avr5  = avr4 | avr.FeatureJMP
+)
+
+// instructionSets lists the architecture of each debugWIRE device, as the
+// name and the flash size don't tell it (e.g. the ATmega8U2 has no
+// multiplier, but has JMP and CALL with 8KB of flash).
+var instructionSets = map[string]avr.Features{
+	"AT90PWM1":        avr4,
+	"AT90PWM161":      avr5,
+	"AT90PWM216":      avr5,
+	"AT90PWM2B":       avr4,
+	"AT90PWM316":      avr5,
+	"AT90PWM3B":       avr4,
+	"AT90PWM81":       avr4,
+	"AT90USB162":      avr35,
+	"AT90USB82":       avr35,
+	"ATmega168":       avr5,
+	"ATmega168A":      avr5,
+	"ATmega168P":      avr5,
+	"ATmega168PA":     avr5,
+	"ATmega168PB":     avr5,
+	"ATmega16HVA":     avr5,
+	"ATmega16HVB":     avr5,
+	"ATmega16HVBrevB": avr5,
+	"ATmega16M1":      avr5,
+	"ATmega16U2":      avr35,
+	"ATmega328":       avr5,
+	"ATmega328P":      avr5,
+	"ATmega328PB":     avr5,
+	"ATmega32C1":      avr5,
+	"ATmega32HVB":     avr5,
+	"ATmega32HVBrevB": avr5,
+	"ATmega32M1":      avr5,
+	"ATmega32U2":      avr35,
+	"ATmega48":        avr4,
+	"ATmega48A":       avr4,
+	"ATmega48P":       avr4,
+	"ATmega48PA":      avr4,
+	"ATmega48PB":      avr4,
+	"ATmega88":        avr4,
+	"ATmega88A":       avr4,
+	"ATmega88P":       avr4,
+	"ATmega88PA":      avr4,
+	"ATmega88PB":      avr4,
+	"ATmega8HVA":      avr4,
+	"ATmega8U2":       avr35,
+	"ATtiny13":        avr25,
+	"ATtiny13A":       avr25,
+	"ATtiny1634":      avr35,
+	"ATtiny167":       avr35,
+	"ATtiny2313":      avr25,
+	"ATtiny2313A":     avr25,
+	"ATtiny24":        avr25,
+	"ATtiny24A":       avr25,
+	"ATtiny25":        avr25,
+	"ATtiny261":       avr25,
+	"ATtiny261A":      avr25,
+	"ATtiny4313":      avr25,
+	"ATtiny43U":       avr25,
+	"ATtiny44":        avr25,
+	"ATtiny441":       avr25,
+	"ATtiny44A":       avr25,
+	"ATtiny45":        avr25,
+	"ATtiny461":       avr25,
+	"ATtiny461A":      avr25,
+	"ATtiny48":        avr25,
+	"ATtiny828":       avr25,
+	"ATtiny84":        avr25,
+	"ATtiny841":       avr25,
+	"ATtiny84A":       avr25,
+	"ATtiny85":        avr25,
+	"ATtiny861":       avr25,
+	"ATtiny861A":      avr25,
+	"ATtiny87":        avr25,
+	"ATtiny88":        avr25,
+}
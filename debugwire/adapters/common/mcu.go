@@ -0,0 +1,66 @@
+package common
+
+import (
+	"strings"
+
+	"github.com/dwtk/devices"
+	"github.com/dwtk/dwtk/avr"
+)
+
+// MCU describes the target device. debugWIRE devices are described by
+// *devices.MCU, and UPDI devices by the updi adapter, as the UPDI devices have
+// no debugWIRE registers.
+type MCU interface {
+	Name() string
+	Signature() uint16
+	FlashPageSize() uint16
+	FlashSize() uint16
+	NRWWOffset() uint16
+	EEPROMSize() uint16
+	EEAR() devices.SFR
+	EEDR() devices.SFR
+	EECR() devices.SFR
+	SPMCSR() devices.SFR
+	SP() devices.SFR
+	SREG() devices.SFR
+	DWENMask() byte
+}
+
+// InstructionSet returns the instruction set features of the target device.
+// MCUs may implement Features() to report them, otherwise they are looked up
+// for a debugWIRE device. Unknown devices get them guessed: ATtiny devices
+// have no multiplier, and devices with more than 8KB of flash have JMP and
+// CALL.
+func InstructionSet(mcu MCU) avr.Features {
+	if f, ok := mcu.(interface{ Features() avr.Features }); ok {
+		return f.Features()
+	}
+	if f, ok := instructionSets[mcu.Name()]; ok {
+		return f
+	}
+
+	rv := avr.FeaturesAVRe
+	if !strings.HasPrefix(strings.ToLower(mcu.Name()), "attiny") {
+		rv |= avr.FeatureMUL
+	}
+	if mcu.FlashSize() > 0x2000 {
+		rv |= avr.FeatureJMP
+	}
+	return rv
+}
+
+// SRAMStart returns the data space address of the internal SRAM, right after
+// the I/O registers. MCUs may implement SRAMStart() to report it, otherwise it
+// depends on the debugWIRE device having extended I/O registers.
+func SRAMStart(mcu MCU) uint16 {
+	if s, ok := mcu.(interface{ SRAMStart() uint16 }); ok {
+		return s.SRAMStart()
+	}
+
+	for _, r := range Registers(mcu) {
+		if r.Address >= 0x60 {
+			return 0x100
+		}
+	}
+	return 0x60
+}
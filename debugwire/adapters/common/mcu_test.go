@@ -0,0 +1,91 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/dwtk/devices"
+	"github.com/dwtk/dwtk/avr"
+)
+
+func TestInstructionSet(t *testing.T) {
+	for _, tc := range []struct {
+		arch  string
+		exp   avr.Features
+		names []string
+	}{
+		{"avr25", avr.FeaturesAVRe, []string{"ATtiny13A", "ATtiny85", "ATtiny88"}},
+		{"avr35", avr.FeaturesAVRe | avr.FeatureJMP, []string{"ATmega8U2", "AT90USB82", "ATmega32U2", "ATtiny1634"}},
+		{"avr4", avr.FeaturesAVReplus, []string{"ATmega48", "ATmega88PA", "AT90PWM81"}},
+		{"avr5", avr.FeaturesAVReplus | avr.FeatureJMP, []string{"ATmega168", "ATmega328P", "AT90PWM161"}},
+	} {
+		t.Run(tc.arch, func(t *testing.T) {
+			for _, name := range tc.names {
+				mcu, err := devices.GetByName(name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if f := InstructionSet(mcu); f != tc.exp {
+					t.Errorf("%s: bad features: 0x%04x != 0x%04x", name, f, tc.exp)
+				}
+			}
+		})
+	}
+}
+
+func TestInstructionSetKnown(t *testing.T) {
+	for _, mcu := range devices.GetAll() {
+		if _, ok := instructionSets[mcu.Name()]; !ok {
+			t.Errorf("%s: instruction set not known", mcu.Name())
+		}
+	}
+}
+
+// guessedMCU is a device missing from the instruction sets.
+type guessedMCU struct {
+	*devices.MCU
+	name string
+}
+
+func (m *guessedMCU) Name() string {
+	return m.name
+}
+
+func TestInstructionSetGuessed(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		base string
+		exp  avr.Features
+	}{
+		{"ATtiny85X", "ATtiny85", avr.FeaturesAVRe},
+		{"ATtiny167X", "ATtiny167", avr.FeaturesAVRe | avr.FeatureJMP},
+		{"ATmega88X", "ATmega88", avr.FeaturesAVReplus},
+		{"ATmega328X", "ATmega328P", avr.FeaturesAVReplus | avr.FeatureJMP},
+	} {
+		mcu, err := devices.GetByName(tc.base)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f := InstructionSet(&guessedMCU{mcu, tc.name}); f != tc.exp {
+			t.Errorf("%s: bad features: 0x%04x != 0x%04x", tc.name, f, tc.exp)
+		}
+	}
+}
+
+func TestSRAMStart(t *testing.T) {
+	for name, exp := range map[string]uint16{
+		"ATtiny85":   0x60,
+		"ATtiny2313": 0x60,
+		"ATtiny88":   0x100,
+		"ATtiny1634": 0x100,
+		"ATmega328P": 0x100,
+		"AT90PWM81":  0x100,
+	} {
+		mcu, err := devices.GetByName(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := SRAMStart(mcu); s != exp {
+			t.Errorf("%s: bad SRAM start: 0x%04x != 0x%04x", name, s, exp)
+		}
+	}
+}
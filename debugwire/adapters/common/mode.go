@@ -0,0 +1,15 @@
+package common
+
+import (
+	"errors"
+)
+
+// ErrPowerCycle is returned when switching the target to debugWIRE mode only
+// works after a target power cycle.
+var ErrPowerCycle = errors.New("debugwire: target power cycle required")
+
+// IsPowerCycle also matches errors that went through remote adapters and
+// transcripts, that only keep the message.
+func IsPowerCycle(err error) bool {
+	return err != nil && err.Error() == ErrPowerCycle.Error()
+}
@@ -0,0 +1,121 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+// the tables are generated from the atdf files of the github.com/dwtk/devices
+// module, at the version required by go.mod. generate.go finds them with
+// `go list -m`, so the module must be in the module cache.
+//go:generate go run generate.go
+
+// Register is an I/O register, at its data space address. Registers with
+// Size 2 are little endian, as the AVR 16-bit registers. ReadSensitive
+// registers have side effects when read (e.g. UDR0 pops the USART receive
+// buffer).
+type Register struct {
+	Name          string
+	Address       uint16
+	Size          byte
+	Caption       string
+	Fields        []*Bitfield
+	ReadSensitive bool
+}
+
+// Bitfield is a group of bits of a register, e.g. a prescaler selection. Its
+// value is the masked bits, packed to the right.
+type Bitfield struct {
+	Name    string
+	Mask    uint16
+	Caption string
+	Values  []Value
+}
+
+// Value is a named value of a bitfield, e.g. a prescaler selection.
+type Value struct {
+	Value   uint16
+	Caption string
+}
+
+// Registers returns the I/O registers of the target device, sorted by
+// address. MCUs may implement Registers() to report them, otherwise they are
+// looked up by name in the tables generated from the atdf files of the
+// github.com/dwtk/devices module.
+func Registers(mcu MCU) []*Register {
+	if r, ok := mcu.(interface{ Registers() []*Register }); ok {
+		return r.Registers()
+	}
+	return registerTables[mcu.Name()]
+}
+
+// LookupRegister returns the register and the optional bitfield named by
+// name, e.g. "PORTB" or "TCCR0B.CS0". Names are case insensitive.
+func LookupRegister(mcu MCU, name string) (*Register, *Bitfield, error) {
+	rname, fname := name, ""
+	if i := strings.Index(name, "."); i >= 0 {
+		rname, fname = name[:i], name[i+1:]
+	}
+
+	for _, r := range Registers(mcu) {
+		if !strings.EqualFold(r.Name, rname) {
+			continue
+		}
+		if fname == "" {
+			return r, nil, nil
+		}
+		for _, f := range r.Fields {
+			if strings.EqualFold(f.Name, fname) {
+				return r, f, nil
+			}
+		}
+		return nil, nil, fmt.Errorf("debugwire: registers: invalid bitfield for %s: %s", r.Name, fname)
+	}
+	return nil, nil, fmt.Errorf("debugwire: registers: invalid register for %s: %s", mcu.Name(), rname)
+}
+
+// Get returns the bitfield value from reg, packed to the right.
+func (b *Bitfield) Get(reg uint16) uint16 {
+	rv := uint16(0)
+	j := uint(0)
+	for i := uint(0); i < 16; i++ {
+		if b.Mask&(1<<i) == 0 {
+			continue
+		}
+		if reg&(1<<i) != 0 {
+			rv |= 1 << j
+		}
+		j++
+	}
+	return rv
+}
+
+// Set returns reg with the bitfield set to v, or an error if v doesn't fit.
+func (b *Bitfield) Set(reg uint16, v uint16) (uint16, error) {
+	j := uint(0)
+	for i := uint(0); i < 16; i++ {
+		if b.Mask&(1<<i) == 0 {
+			continue
+		}
+		if v&(1<<j) != 0 {
+			reg |= 1 << i
+		} else {
+			reg &^= 1 << i
+		}
+		j++
+	}
+	if j < 16 && v>>j != 0 {
+		return 0, fmt.Errorf("debugwire: registers: invalid value for %s: 0x%x", b.Name, v)
+	}
+	return reg, nil
+}
+
+// Describe returns the caption of the bitfield value v, if known.
+func (b *Bitfield) Describe(v uint16) string {
+	for _, val := range b.Values {
+		if val.Value == v {
+			return val.Caption
+		}
+	}
+	return ""
+}
@@ -0,0 +1,16 @@
+package common
+
+import (
+	"strings"
+)
+
+// SplitSimSpec splits the specification of a simulated target, that is the
+// MCU name optionally followed by a firmware file to preload into flash,
+// e.g. "atmega328p" or "atmega328p firmware.elf".
+func SplitSimSpec(spec string) (mcu string, firmware string) {
+	p := strings.SplitN(strings.TrimSpace(spec), " ", 2)
+	if len(p) < 2 {
+		return p[0], ""
+	}
+	return p[0], strings.TrimSpace(p[1])
+}
@@ -2,10 +2,14 @@ package dwtkice
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
-	"time"
 
 	"github.com/dwtk/dwtk/internal/logger"
+	"github.com/dwtk/dwtk/internal/selector"
 	"github.com/rafaelmartins/usbfs"
 )
 
@@ -17,6 +21,7 @@ const (
 const (
 	capDw = (1 << iota)
 	capSpi
+	capWaitHalt
 )
 
 const (
@@ -52,6 +57,12 @@ const (
 	cmdWriteFlashPage
 	cmdEraseFlashPage
 	cmdReadFuses
+
+	// cmdWaitHalt blocks until the target halts or val milliseconds elapse,
+	// and answers 1 if the target halted or 0 if it is still running. only
+	// sent to firmware reporting capWaitHalt, older firmware is polled with
+	// cmdWait, that answers the same without blocking.
+	cmdWaitHalt
 )
 
 const (
@@ -101,6 +112,7 @@ var (
 		cmdWriteFlashPage:   "cmdWriteFlashPage",
 		cmdEraseFlashPage:   "cmdEraseFlashPage",
 		cmdReadFuses:        "cmdReadFuses",
+		cmdWaitHalt:         "cmdWaitHalt",
 	}
 
 	iceErrors = map[byte]func(byte, byte) error{
@@ -114,7 +126,7 @@ var (
 			return fmt.Errorf("debugwire: dwtk-ice: got unexpected byte echoed back via SPI: expected 0x%02x, got 0x%02x", exp, got)
 		},
 		errBaudrateDetection: func(_ byte, _ byte) error {
-			return fmt.Errorf("debugwire: dwtk-ice: baudrate detection failed")
+			return errDetectBaudrate
 		},
 		errEchoMismatch: func(exp byte, got byte) error {
 			return fmt.Errorf("debugwire: dwtk-ice: got unexpected byte echoed back: expected 0x%02x, got 0x%02x", exp, got)
@@ -145,13 +157,83 @@ func codeToError(e []byte) error {
 }
 
 type device struct {
-	dev *usbfs.Device
-	spi bool
+	dev      Transport
+	spi      bool
+	waitHalt bool
+
+	// called when the target answers with a different baudrate, to detect
+	// it again.
+	resync    func() error
+	resyncing bool
+}
+
+// reads that are safe to run again after a resync. writes and flow control
+// requests may have partially run, and fail with the original error.
+var idempotent = map[byte]bool{
+	cmdReadSignature: true,
+	cmdGetPC:         true,
+	cmdRegisters:     true,
+	cmdSRAM:          true,
+	cmdReadFlash:     true,
+	cmdReadFuses:     true,
+}
+
+// requests that wait for the target to halt. resyncing leaves it halted, so
+// they succeed once resynced.
+var halting = map[byte]bool{
+	cmdSendBreak: true,
+	cmdRecvBreak: true,
+}
+
+func isDesync(e []byte) bool {
+	return len(e) > 0 && (e[0] == errEchoMismatch || e[0] == errBreakMismatch)
+}
+
+// usbPaths maps serial numbers of attached dwtk-ice devices to their USB
+// port paths, as usbfs does not expose them.
+func usbPaths() map[string]string {
+	rv := map[string]string{}
+	dirs, err := filepath.Glob("/sys/bus/usb/devices/*")
+	if err != nil {
+		return rv
+	}
+	for _, dir := range dirs {
+		name := filepath.Base(dir)
+		if strings.Contains(name, ":") {
+			continue
+		}
+		idVendor, err := ioutil.ReadFile(filepath.Join(dir, "idVendor"))
+		if err != nil || strings.TrimSpace(string(idVendor)) != fmt.Sprintf("%04x", vid) {
+			continue
+		}
+		idProduct, err := ioutil.ReadFile(filepath.Join(dir, "idProduct"))
+		if err != nil || strings.TrimSpace(string(idProduct)) != fmt.Sprintf("%04x", pid) {
+			continue
+		}
+		serial, err := ioutil.ReadFile(filepath.Join(dir, "serial"))
+		if err != nil {
+			continue
+		}
+		rv[strings.TrimSpace(string(serial))] = name
+	}
+	return rv
 }
 
-func newDevice(serialNumber string) (*device, error) {
-	serials := []string{}
-	devices, err := usbfs.List(func(d *usbfs.Device) bool {
+type usbDevice struct {
+	dev     *usbfs.Device
+	serial  string
+	usbPath string
+}
+
+func listUsbDevices(rule string) ([]*usbDevice, error) {
+	if _, err := os.Stat("/sys/bus/usb/devices"); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	paths := usbPaths()
+	rv := []*usbDevice{}
+	var errMatch error
+	_, err := usbfs.List(func(d *usbfs.Device) bool {
 		idVendor, err := d.IdVendor()
 		if err != nil || idVendor != vid {
 			return false
@@ -167,33 +249,67 @@ func newDevice(serialNumber string) (*device, error) {
 			return false
 		}
 
-		if serialNumber != "" && serial != serialNumber {
+		match, err := selector.Match(rule, serial, paths[serial])
+		if err != nil {
+			errMatch = err
 			return false
 		}
+		if match {
+			rv = append(rv, &usbDevice{
+				dev:     d,
+				serial:  serial,
+				usbPath: paths[serial],
+			})
+		}
+		return match
+	})
+	if err != nil {
+		return nil, err
+	}
+	if errMatch != nil {
+		return nil, errMatch
+	}
 
-		serials = append(serials, serial)
-		return true
+	sort.Slice(rv, func(i int, j int) bool {
+		return rv[i].serial < rv[j].serial
 	})
+	return rv, nil
+}
+
+func newDevice(rule string) (*device, error) {
+	devices, err := listUsbDevices(rule)
 	if err != nil {
 		return nil, err
 	}
 	if len(devices) == 0 {
-		if serialNumber != "" {
-			return nil, fmt.Errorf("debugwire: dwtk-ice: device not found: %s", serialNumber)
+		if rule != "" {
+			return nil, fmt.Errorf("debugwire: dwtk-ice: device not found: %s", rule)
 		}
 		return nil, nil
 	}
 	if len(devices) > 1 {
-		return nil, fmt.Errorf("debugwire: dwtk-ice: more than one device found. this is not supported: %s",
+		serials := []string{}
+		for _, d := range devices {
+			serials = append(serials, d.serial)
+		}
+		if rule != "" {
+			return nil, fmt.Errorf("debugwire: dwtk-ice: more than one device matches %q, select one by serial number, glob or usb:PATH (see `dwtk adapters`): %s",
+				rule, strings.Join(serials, ", "))
+		}
+		return nil, fmt.Errorf("debugwire: dwtk-ice: more than one device found, select one by serial number, glob or usb:PATH (see `dwtk adapters`): %s",
 			strings.Join(serials, ", "))
 	}
-	dev := devices[0]
+	dev := devices[0].dev
 
 	if err := dev.Open(); err != nil {
 		return nil, err
 	}
+	return newDeviceWithTransport(&usbTransport{dev: dev})
+}
+
+func newDeviceWithTransport(t Transport) (*device, error) {
 	rv := &device{
-		dev: dev,
+		dev: t,
 		spi: false,
 	}
 	b := make([]byte, 1)
@@ -206,6 +322,9 @@ func newDevice(serialNumber string) (*device, error) {
 	if b[0]&capSpi != 0 {
 		rv.spi = true
 	}
+	if b[0]&capWaitHalt != 0 {
+		rv.waitHalt = true
+	}
 	return rv, nil
 }
 
@@ -229,16 +348,65 @@ func (d *device) getSerial() string {
 	return rv
 }
 
-func (d *device) controlGetError() error {
+func (d *device) getError() ([]byte, error) {
 	f := make([]byte, 3)
-	if err := d.dev.Control(usbfs.RequestTypeVendor, usbfs.RequestRecipientDevice, usbfs.DirectionIn, cmdGetError, 0, 0, f, 5*time.Second); err != nil {
-		return err
+	if err := d.dev.Control(usbfs.DirectionIn, cmdGetError, 0, 0, f); err != nil {
+		return nil, err
 	}
 	logger.Debug.Printf("<<< cmdGetError: 0x%02x -> [0x%02x, 0x%02x]", f[0], f[1], f[2])
+	return f, nil
+}
+
+func (d *device) controlGetError() error {
+	f, err := d.getError()
+	if err != nil {
+		return err
+	}
 	return codeToError(f)
 }
 
+// retry resyncs after a desync of a read or break request, and tells if the
+// request must run again.
+func (d *device) retry(req byte, e []byte) (bool, error) {
+	if !isDesync(e) || d.resync == nil || d.resyncing || !(idempotent[req] || halting[req]) {
+		return false, codeToError(e)
+	}
+
+	d.resyncing = true
+	err := d.resync()
+	d.resyncing = false
+	if err != nil {
+		return false, fmt.Errorf("%s (resync failed: %s)", codeToError(e), err)
+	}
+	return idempotent[req], nil
+}
+
 func (d *device) controlIn(req byte, val uint16, idx uint16, data []byte) error {
+	e, err := d.rawControlIn(req, val, idx, data)
+	if err != nil {
+		return err
+	}
+	again, err := d.retry(req, e)
+	if again {
+		e, err = d.rawControlIn(req, val, idx, data)
+		if err != nil {
+			return err
+		}
+		return codeToError(e)
+	}
+	return err
+}
+
+// controlOut requests are writes, and are never retried.
+func (d *device) controlOut(req byte, val uint16, idx uint16, data []byte) error {
+	e, err := d.rawControlOut(req, val, idx, data)
+	if err != nil {
+		return err
+	}
+	return codeToError(e)
+}
+
+func (d *device) rawControlIn(req byte, val uint16, idx uint16, data []byte) ([]byte, error) {
 	cmd, ok := cmds[req]
 	if ok {
 		logger.Debug.Printf("<<< %s(0x%04x, 0x%04x)", cmd, val, idx)
@@ -246,29 +414,29 @@ func (d *device) controlIn(req byte, val uint16, idx uint16, data []byte) error
 		logger.Debug.Printf("<<< %d(0x%04x, 0x%04x)", req, val, idx)
 	}
 	f := make([]byte, len(data)+3)
-	if err := d.dev.Control(usbfs.RequestTypeVendor, usbfs.RequestRecipientDevice, usbfs.DirectionIn, req, val, idx, f, 5*time.Second); err != nil {
-		return err
+	if err := d.dev.Control(usbfs.DirectionIn, req, val, idx, f); err != nil {
+		return nil, err
 	}
 	logger.Debug.Printf("<<< error: 0x%02x -> [0x%02x, 0x%02x]", f[0], f[1], f[2])
 	for i, c := range f[3:] {
 		data[i] = c
 		logger.Debug.Printf("<<< 0x%02x", c)
 	}
-	return codeToError(f)
+	return f[:3], nil
 }
 
-func (d *device) controlOut(req byte, val uint16, idx uint16, data []byte) error {
+func (d *device) rawControlOut(req byte, val uint16, idx uint16, data []byte) ([]byte, error) {
 	cmd, ok := cmds[req]
 	if ok {
 		logger.Debug.Printf(">>> %s(0x%04x, 0x%04x)", cmd, val, idx)
 	} else {
 		logger.Debug.Printf(">>> %d(0x%04x, 0x%04x)", req, val, idx)
 	}
-	if err := d.dev.Control(usbfs.RequestTypeVendor, usbfs.RequestRecipientDevice, usbfs.DirectionOut, req, val, idx, data, 5*time.Second); err != nil {
-		return err
+	if err := d.dev.Control(usbfs.DirectionOut, req, val, idx, data); err != nil {
+		return nil, err
 	}
 	for _, c := range data {
 		logger.Debug.Printf(">>> 0x%02x", c)
 	}
-	return d.controlGetError()
+	return d.getError()
 }
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/dwtk/devices"
 	"github.com/dwtk/dwtk/debugwire/adapters/common"
 	"github.com/dwtk/dwtk/internal/logger"
 )
@@ -13,17 +12,24 @@ import (
 var (
 	errNotSupportedDw  = errors.New("debugwire: dwtk-ice: operation not supported: target running on debugWIRE mode, try `dwtk disable`")
 	errNotSupportedSpi = errors.New("debugwire: dwtk-ice: operation not supported: target running on SPI ISP mode, try `dwtk enable`")
+	errNoPageEraseSpi  = errors.New("debugwire: dwtk-ice: SPI ISP can't erase flash pages, use `dwtk erase-chip`")
 	errCmdUnsupported  = errors.New("debugwire: dwtk-ice: command not supported")
+	errDetectBaudrate  = errors.New("debugwire: dwtk-ice: baudrate detection failed")
 )
 
 type DwtkIceAdapter struct {
 	dev            *device
 	spi            *spiCommands
-	mcu            *devices.MCU
+	mcu            common.MCU
 	ubrr           uint16
 	targetBaudrate uint32
 	actualBaudrate uint32
 	spiMode        bool
+
+	// older firmwares can't read fuses natively. this is only probed when
+	// reading the fuses, as it clobbers the target registers.
+	nativeFuses      bool
+	nativeFusesKnown bool
 }
 
 func New(serialNumber string) (*DwtkIceAdapter, error) {
@@ -31,6 +37,18 @@ func New(serialNumber string) (*DwtkIceAdapter, error) {
 	if dev == nil || err != nil {
 		return nil, err
 	}
+	return newFromDevice(dev)
+}
+
+func NewWithTransport(t Transport) (*DwtkIceAdapter, error) {
+	dev, err := newDeviceWithTransport(t)
+	if err != nil {
+		return nil, err
+	}
+	return newFromDevice(dev)
+}
+
+func newFromDevice(dev *device) (*DwtkIceAdapter, error) {
 	serial := dev.getSerial()
 	if serial != "" {
 		logger.Debug.Printf(" * Detected dwtk-ice %s (SN: %s)", dev.getVersion(), serial)
@@ -43,9 +61,41 @@ func New(serialNumber string) (*DwtkIceAdapter, error) {
 		spi = newSpiCommands(dev)
 	}
 
-	if err := dev.controlIn(cmdDetectBaudrate, 0, 0, nil); err != nil {
+	if errOrig := detectBaudrate(dev); errOrig != nil {
+		if !dev.spi {
+			return nil, errOrig
+		}
+		if err := spi.enable(); err != nil {
+			return nil, errOrig
+		}
+		return &DwtkIceAdapter{dev: dev, spi: spi, spiMode: true}, nil
+	}
+
+	rv := &DwtkIceAdapter{
+		dev: dev,
+		spi: spi,
+	}
+	if err := rv.initDebugWIRE(); err != nil {
 		return nil, err
 	}
+	return rv, nil
+}
+
+// initDebugWIRE is called after detecting the baudrate successfully.
+func (dw *DwtkIceAdapter) initDebugWIRE() error {
+	if err := dw.readBaudrate(); err != nil {
+		return err
+	}
+
+	dw.spiMode = false
+	dw.dev.resync = dw.resync
+	return nil
+}
+
+func detectBaudrate(dev *device) error {
+	if err := dev.controlIn(cmdDetectBaudrate, 0, 0, nil); err != nil {
+		return err
+	}
 
 	// we need a delay here to avoid issuing an usb request while dwtk-ice is
 	// detecting baudrate with interrupts disabled.
@@ -57,40 +107,37 @@ func New(serialNumber string) (*DwtkIceAdapter, error) {
 	// with some margin, we set 30ms because why not
 	time.Sleep(30 * time.Millisecond)
 
-	if errOrig := dev.controlGetError(); errOrig != nil {
-		if !dev.spi {
-			return nil, errOrig
-		}
-		if err := spi.enable(); err != nil {
-			return nil, errOrig
-		}
-		return &DwtkIceAdapter{dev: dev, spi: spi, spiMode: true}, nil
-	}
+	return dev.controlGetError()
+}
 
+func (dw *DwtkIceAdapter) readBaudrate() error {
 	f := make([]byte, 6)
-	if err := dev.controlIn(cmdGetBaudrate, 0, 0, f); err != nil {
-		return nil, err
+	if err := dw.dev.controlIn(cmdGetBaudrate, 0, 0, f); err != nil {
+		return err
 	}
 
 	if f[1] == 0 {
-		return nil, fmt.Errorf("debugwire: dwtk-ice: invalid baudrate prescaler: 0")
+		return fmt.Errorf("debugwire: dwtk-ice: invalid baudrate prescaler: 0")
 	}
 	if f[2] == 0 && f[3] == 0 {
-		return nil, fmt.Errorf("debugwire: dwtk-ice: invalid pulse width: 0")
+		return fmt.Errorf("debugwire: dwtk-ice: invalid pulse width: 0")
 	}
 
-	ubrr := (uint16(f[4]) << 8) | uint16(f[5])
-	rv := &DwtkIceAdapter{
-		dev:            dev,
-		spi:            spi,
-		ubrr:           ubrr,
-		actualBaudrate: (uint32(f[0]) * 1000000) / uint32(uint16(f[1])*(ubrr+1)),
-		targetBaudrate: (uint32(f[0]) * 1000000) / uint32((uint16(f[2])<<8)|uint16(f[3])),
-		spiMode:        false,
-	}
+	dw.ubrr = (uint16(f[4]) << 8) | uint16(f[5])
+	dw.actualBaudrate = (uint32(f[0]) * 1000000) / uint32(uint16(f[1])*(dw.ubrr+1))
+	dw.targetBaudrate = (uint32(f[0]) * 1000000) / uint32((uint16(f[2])<<8)|uint16(f[3]))
 
-	logger.Debug.Printf(" * Actual baudrate: %d", rv.actualBaudrate)
-	return rv, nil
+	logger.Debug.Printf(" * Actual baudrate: %d", dw.actualBaudrate)
+	return nil
+}
+
+// resync detects the baudrate again, after the target changed its clock.
+func (dw *DwtkIceAdapter) resync() error {
+	logger.Debug.Printf(" * debugWIRE desync detected at %d bps, detecting baudrate again", dw.actualBaudrate)
+	if err := detectBaudrate(dw.dev); err != nil {
+		return err
+	}
+	return dw.readBaudrate()
 }
 
 func (dw *DwtkIceAdapter) Close() error {
@@ -100,6 +147,14 @@ func (dw *DwtkIceAdapter) Close() error {
 	return dw.dev.close()
 }
 
+func (dw *DwtkIceAdapter) ID() string {
+	serial := dw.dev.getSerial()
+	if serial == "" {
+		return "dwtk-ice"
+	}
+	return "dwtk-ice " + serial
+}
+
 func (dw *DwtkIceAdapter) Info() string {
 	info := ""
 	serial := dw.dev.getSerial()
@@ -126,11 +181,27 @@ Baudrate Register: 0x%04x
 	return info
 }
 
-func (dw *DwtkIceAdapter) SetMCU(mcu *devices.MCU) {
+func (dw *DwtkIceAdapter) Capabilities() common.Capabilities {
+	rv := common.CapMemory
+	if dw.spiMode {
+		rv |= common.CapSpiIsp | common.CapFuseWrite | common.CapChipErase | common.CapModeSwitch
+	} else {
+		rv |= common.CapDebugWIRE | common.CapTimers
+		if dw.dev.spi {
+			rv |= common.CapModeSwitch
+		}
+	}
+	if dw.spiMode || dw.nativeFuses {
+		rv |= common.CapNativeFuseRead
+	}
+	return rv
+}
+
+func (dw *DwtkIceAdapter) SetMCU(mcu common.MCU) {
 	dw.mcu = mcu
 }
 
-func (dw *DwtkIceAdapter) GetMCU() *devices.MCU {
+func (dw *DwtkIceAdapter) GetMCU() common.MCU {
 	return dw.mcu
 }
 
@@ -177,6 +248,40 @@ func (dw *DwtkIceAdapter) Disable() error {
 	return nil
 }
 
+func (dw *DwtkIceAdapter) SwitchMode(debugWIRE bool) error {
+	if debugWIRE != dw.spiMode {
+		return nil
+	}
+	if !dw.dev.spi {
+		return errors.New("debugwire: dwtk-ice: mode switch requires SPI ISP support")
+	}
+
+	if !debugWIRE {
+		// debugWIRE stays disabled until the next target power cycle.
+		if err := dw.dev.controlIn(cmdDisable, 0, 0, nil); err != nil {
+			return err
+		}
+		if err := dw.spi.enable(); err != nil {
+			return err
+		}
+		dw.spiMode = true
+		dw.dev.resync = nil
+		return nil
+	}
+
+	// the DWEN fuse must be programmed already.
+	if err := dw.dev.controlIn(cmdSpiReset, 0, 0, nil); err != nil {
+		return err
+	}
+	if err := detectBaudrate(dw.dev); err != nil {
+		if err == errDetectBaudrate {
+			return common.ErrPowerCycle
+		}
+		return err
+	}
+	return dw.initDebugWIRE()
+}
+
 func (dw *DwtkIceAdapter) Reset() error {
 	if dw.spiMode {
 		return dw.dev.controlIn(cmdSpiReset, 0, 0, nil)
@@ -328,7 +433,7 @@ func (dw *DwtkIceAdapter) ReadSRAM(start uint16, data []byte) error {
 
 func (dw *DwtkIceAdapter) WriteFlashPage(start uint16, data []byte) error {
 	if dw.spiMode {
-		return errNotSupportedSpi
+		return dw.spi.writeFlashPage(start, data)
 	}
 
 	return dw.dev.controlOut(cmdWriteFlashPage, start, dw.mcu.NRWWOffset(), data)
@@ -336,7 +441,7 @@ func (dw *DwtkIceAdapter) WriteFlashPage(start uint16, data []byte) error {
 
 func (dw *DwtkIceAdapter) EraseFlashPage(start uint16) error {
 	if dw.spiMode {
-		return errNotSupportedSpi
+		return errNoPageEraseSpi
 	}
 
 	return dw.dev.controlIn(cmdEraseFlashPage, start, dw.mcu.NRWWOffset(), nil)
@@ -344,12 +449,30 @@ func (dw *DwtkIceAdapter) EraseFlashPage(start uint16) error {
 
 func (dw *DwtkIceAdapter) ReadFlash(start uint16, data []byte) error {
 	if dw.spiMode {
-		return errNotSupportedSpi
+		return dw.spi.readFlash(start, data)
 	}
 
 	return dw.dev.controlIn(cmdReadFlash, start, 0, data)
 }
 
+// on debugWIRE mode, EEPROM is accessed with instructions, by the debugwire
+// package.
+func (dw *DwtkIceAdapter) ReadEEPROM(start uint16, data []byte) error {
+	if !dw.spiMode {
+		return errNotSupportedDw
+	}
+
+	return dw.spi.readEEPROM(start, data)
+}
+
+func (dw *DwtkIceAdapter) WriteEEPROM(start uint16, data []byte) error {
+	if !dw.spiMode {
+		return errNotSupportedDw
+	}
+
+	return dw.spi.writeEEPROM(start, data)
+}
+
 func (dw *DwtkIceAdapter) ReadFuses() ([]byte, error) {
 	f := make([]byte, 4)
 	if dw.spiMode {
@@ -373,15 +496,21 @@ func (dw *DwtkIceAdapter) ReadFuses() ([]byte, error) {
 		return f, nil
 	}
 
+	if dw.nativeFusesKnown && !dw.nativeFuses {
+		return common.ReadFuses(dw)
+	}
+
 	err := dw.dev.controlIn(cmdReadFuses, 0, 0, f)
-	if err == nil {
-		return f, nil
+	if err == errCmdUnsupported && !dw.nativeFusesKnown {
+		dw.nativeFusesKnown = true
+		return common.ReadFuses(dw)
 	}
-	if err != errCmdUnsupported {
+	if err != nil {
 		return nil, err
 	}
-
-	return common.ReadFuses(dw)
+	dw.nativeFuses = true
+	dw.nativeFusesKnown = true
+	return f, nil
 }
 
 func (dw *DwtkIceAdapter) WriteLFuse(data byte) error {
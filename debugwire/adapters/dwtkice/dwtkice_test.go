@@ -0,0 +1,236 @@
+package dwtkice
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+	"github.com/dwtk/dwtk/debugwire/adapters/sim"
+	"github.com/dwtk/dwtk/internal/adaptertest"
+)
+
+func newTestAdapter(t *testing.T, unsupported ...byte) (*DwtkIceAdapter, *FakeIce) {
+	t.Helper()
+
+	s, err := sim.New("atmega328p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ice := NewFakeIce(s)
+	for _, req := range unsupported {
+		ice.SetUnsupported(req)
+	}
+	a, err := NewWithTransport(ice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sign, err := a.ReadSignature()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mcu, err := ice.mcu()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mcu.Signature() != sign {
+		t.Fatalf("bad signature: 0x%04x", sign)
+	}
+	a.SetMCU(mcu)
+	return a, ice
+}
+
+func TestConformance(t *testing.T) {
+	adaptertest.Run(t, func(t *testing.T) (adaptertest.Adapter, func()) {
+		a, _ := newTestAdapter(t)
+		return a, func() {
+			a.Close()
+		}
+	})
+}
+
+func TestWait(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		unsupported []byte
+		sent        byte
+		notSent     byte
+	}{
+		{"Blocking", nil, cmdWaitHalt, cmdWait},
+		{"OldFirmware", []byte{cmdWaitHalt}, cmdWait, cmdWaitHalt},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a, ice := newTestAdapter(t, tc.unsupported...)
+			defer a.Close()
+
+			adaptertest.WriteProgram(t, a, `
+				nop
+				break
+			`)
+
+			if err := a.SetPC(0); err != nil {
+				t.Fatal(err)
+			}
+			if err := a.Continue(0, false, false); err != nil {
+				t.Fatal(err)
+			}
+			adaptertest.WaitHalt(t, a)
+			if err := a.RecvBreak(); err != nil {
+				t.Fatal(err)
+			}
+			adaptertest.CheckPC(t, a, 2)
+
+			if n := ice.Requests(tc.notSent); n != 0 {
+				t.Fatalf("%s sent: %d", cmds[tc.notSent], n)
+			}
+			if ice.Requests(tc.sent) == 0 {
+				t.Fatalf("%s not sent", cmds[tc.sent])
+			}
+		})
+	}
+}
+
+func TestFailure(t *testing.T) {
+	a, ice := newTestAdapter(t)
+	defer a.Close()
+
+	for _, tc := range []struct {
+		code byte
+		arg1 byte
+		arg2 byte
+		exp  string
+	}{
+		{errUnsupported, 0, 0, errCmdUnsupported.Error()},
+		{errSpiPgmEnable, 0, 0, "debugwire: dwtk-ice: SPI programming enable failed"},
+		{errSpiEchoMismatch, 0x12, 0x34, "debugwire: dwtk-ice: got unexpected byte echoed back via SPI: expected 0x12, got 0x34"},
+		{errBaudrateDetection, 0, 0, errDetectBaudrate.Error()},
+		{errEchoMismatch, 0x12, 0x34, "debugwire: dwtk-ice: got unexpected byte echoed back: expected 0x12, got 0x34"},
+		{errBreakMismatch, 0x12, 0, "debugwire: dwtk-ice: got unexpected break value: expected 0x55, got 0x12"},
+		{errTooLarge, 0, 0, "debugwire: dwtk-ice: read/write data is too large"},
+		{0x7f, 0, 0, "debugwire: dwtk-ice: unrecognized hardware error: 0x7f"},
+	} {
+		// writes are never retried, so the error is reported as is.
+		ice.FailNext(cmdSetPC, tc.code, tc.arg1, tc.arg2)
+		err := a.SetPC(0x10)
+		if err == nil {
+			t.Fatalf("scripted failure 0x%02x not reported", tc.code)
+		}
+		if err.Error() != tc.exp {
+			t.Fatalf("bad error for 0x%02x: %q != %q", tc.code, err, tc.exp)
+		}
+		adaptertest.CheckPC(t, a, 0)
+	}
+
+	ice.FailNext(cmdGetPC, errUnsupported, 0, 0)
+	if _, err := a.GetPC(); err != errCmdUnsupported {
+		t.Fatalf("bad error: %v", err)
+	}
+	adaptertest.CheckPC(t, a, 0)
+}
+
+func TestReadFusesFallback(t *testing.T) {
+	a, ice := newTestAdapter(t, cmdReadFuses)
+	defer a.Close()
+
+	// probing clobbers the target registers, so it waits for a fuse read.
+	if n := ice.Requests(cmdReadFuses); n != 0 {
+		t.Fatalf("cmdReadFuses probed when initializing: %d", n)
+	}
+
+	exp, err := ice.target.ReadFuses()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		fuses, err := a.ReadFuses()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(fuses, exp) {
+			t.Fatalf("bad fuses: %v != %v", fuses, exp)
+		}
+	}
+	if a.Capabilities().Has(common.CapNativeFuseRead) {
+		t.Fatal("native fuse read reported as supported")
+	}
+
+	// only probed once.
+	if n := ice.Requests(cmdReadFuses); n != 1 {
+		t.Fatalf("bad cmdReadFuses requests: %d", n)
+	}
+}
+
+func TestSpiFallback(t *testing.T) {
+	s, err := sim.New("atmega328p")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// DWEN unprogrammed, baudrate detection fails.
+	if err := s.WriteHFuse(0xff); err != nil {
+		t.Fatal(err)
+	}
+	ice := NewFakeIce(s)
+	a, err := NewWithTransport(ice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Close()
+
+	if !a.Capabilities().Has(common.CapSpiIsp) || a.Capabilities().Has(common.CapDebugWIRE) {
+		t.Fatalf("bad capabilities: %v", a.Capabilities())
+	}
+	if ice.Requests(cmdSpiPgmEnable) == 0 {
+		t.Fatal("cmdSpiPgmEnable not sent")
+	}
+
+	sign, err := a.ReadSignature()
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp, err := s.ReadSignature()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sign != exp {
+		t.Fatalf("bad signature: 0x%04x != 0x%04x", sign, exp)
+	}
+	if _, err := a.GetPC(); err != errNotSupportedSpi {
+		t.Fatalf("bad error: %v", err)
+	}
+
+	// without SPI, the detection error is reported.
+	s2, err := sim.New("atmega328p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s2.WriteHFuse(0xff); err != nil {
+		t.Fatal(err)
+	}
+	ice2 := NewFakeIce(s2)
+	ice2.SetCapabilities(true, false)
+	if _, err := NewWithTransport(ice2); err != errDetectBaudrate {
+		t.Fatalf("bad error: %v", err)
+	}
+}
+
+func TestDesync(t *testing.T) {
+	a, ice := newTestAdapter(t)
+	defer a.Close()
+
+	if err := a.SetPC(0x10); err != nil {
+		t.Fatal(err)
+	}
+
+	ice.FailNext(cmdGetPC, errEchoMismatch, 0, 0)
+	adaptertest.CheckPC(t, a, 0x10)
+
+	ice.FailNext(cmdSetPC, errEchoMismatch, 0, 0)
+	if err := a.SetPC(0x20); err == nil {
+		t.Fatal("desync of a write not reported")
+	}
+
+	ice.FailNext(cmdWriteFlashPage, errEchoMismatch, 0, 0)
+	if err := a.WriteFlashPage(0, make([]byte, a.GetMCU().FlashPageSize())); err == nil {
+		t.Fatal("desync of a flash write not reported")
+	}
+}
@@ -0,0 +1,351 @@
+package dwtkice
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dwtk/devices"
+	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/internal/adaptertest"
+	"github.com/rafaelmartins/usbfs"
+)
+
+// FakeTarget is the target device behind a FakeIce. The simulator adapter
+// implements it.
+type FakeTarget interface {
+	Close() error
+	Reset() error
+	ReadSignature() (uint16, error)
+	ChipErase() error
+	SendBreak() error
+	RecvBreak() error
+	Go() error
+	Step() error
+	Continue(hwBreakpoint uint16, hwBreakpointSet bool, timers bool) error
+	Wait(ctx context.Context, c chan bool) error
+	WriteInstruction(inst uint16) error
+	SetPC(pc uint16) error
+	GetPC() (uint16, error)
+	WriteRegisters(start byte, regs []byte) error
+	ReadRegisters(start byte, regs []byte) error
+	WriteSRAM(start uint16, data []byte) error
+	ReadSRAM(start uint16, data []byte) error
+	ReadFlash(start uint16, data []byte) error
+	WriteFlashPage(start uint16, data []byte) error
+	EraseFlashPage(start uint16) error
+	ReadEEPROM(start uint16, data []byte) error
+	WriteEEPROM(start uint16, data []byte) error
+	ReadFuses() ([]byte, error)
+	WriteLFuse(data byte) error
+	WriteHFuse(data byte) error
+	WriteEFuse(data byte) error
+	WriteLock(data byte) error
+}
+
+// FakeIce is a Transport that implements the dwtk-ice vendor requests on top
+// of a FakeTarget. Failures can be scripted per request.
+type FakeIce struct {
+	target      FakeTarget
+	caps        byte
+	serial      string
+	bcdDevice   uint16
+	unsupported map[byte]bool
+	failures    map[byte][][]byte
+	requests    map[byte]int
+	lastError   []byte
+	spiMode     bool
+	spiEnabled  bool
+	dwDisabled  bool
+	spi         *adaptertest.FakeSpi
+	mutex       *sync.Mutex
+}
+
+func NewFakeIce(target FakeTarget) *FakeIce {
+	return &FakeIce{
+		target:      target,
+		caps:        capDw | capSpi | capWaitHalt,
+		serial:      "fake",
+		bcdDevice:   0x0100,
+		unsupported: map[byte]bool{},
+		failures:    map[byte][][]byte{},
+		requests:    map[byte]int{},
+		lastError:   []byte{errNone, 0, 0},
+		spi:         adaptertest.NewFakeSpi(target),
+		mutex:       &sync.Mutex{},
+	}
+}
+
+// SetCapabilities sets the capabilities reported to the host.
+func (f *FakeIce) SetCapabilities(dw bool, spi bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.caps &= capWaitHalt
+	if dw {
+		f.caps |= capDw
+	}
+	if spi {
+		f.caps |= capSpi
+	}
+}
+
+// SetUnsupported makes the fake reply errUnsupported to a request, like
+// older firmware versions.
+func (f *FakeIce) SetUnsupported(req byte) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.unsupported[req] = true
+	if req == cmdWaitHalt {
+		f.caps &^= capWaitHalt
+	}
+}
+
+// FailNext makes the next call of a request fail with the given error
+// trailer, without touching the target.
+func (f *FakeIce) FailNext(req byte, code byte, arg1 byte, arg2 byte) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.failures[req] = append(f.failures[req], []byte{code, arg1, arg2})
+}
+
+// Requests returns how many times a request was received.
+func (f *FakeIce) Requests(req byte) int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.requests[req]
+}
+
+// PowerCycle emulates a target power cycle, that enables debugWIRE again
+// after a cmdDisable.
+func (f *FakeIce) PowerCycle() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.dwDisabled = false
+	f.spiEnabled = false
+	f.spi.Reset()
+}
+
+func (f *FakeIce) BcdDevice() (uint16, error) {
+	return f.bcdDevice, nil
+}
+
+func (f *FakeIce) Serial() (string, error) {
+	return f.serial, nil
+}
+
+func (f *FakeIce) Close() error {
+	return f.target.Close()
+}
+
+func (f *FakeIce) Control(dir usbfs.Direction, req byte, val uint16, idx uint16, data []byte) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.requests[req]++
+	if req == cmdGetError {
+		if dir != usbfs.DirectionIn || len(data) < 3 {
+			return fmt.Errorf("debugwire: dwtk-ice: fake: invalid cmdGetError request")
+		}
+		copy(data, f.lastError)
+		return nil
+	}
+
+	var payload []byte
+	if dir == usbfs.DirectionIn {
+		if len(data) < 3 {
+			return fmt.Errorf("debugwire: dwtk-ice: fake: response buffer too small: %d", len(data))
+		}
+		payload = data[3:]
+	} else {
+		payload = data
+	}
+
+	trailer, err := f.handle(dir, req, val, idx, payload)
+	if err != nil {
+		return err
+	}
+
+	f.lastError = trailer
+	if dir == usbfs.DirectionIn {
+		if req == cmdDetectBaudrate {
+			// the detection result is only available via cmdGetError.
+			copy(data, []byte{errNone, 0, 0})
+		} else {
+			copy(data, trailer)
+		}
+	}
+	return nil
+}
+
+func (f *FakeIce) handle(dir usbfs.Direction, req byte, val uint16, idx uint16, data []byte) ([]byte, error) {
+	if failures := f.failures[req]; len(failures) > 0 {
+		f.failures[req] = failures[1:]
+		return failures[0], nil
+	}
+	if f.unsupported[req] {
+		return []byte{errUnsupported, 0, 0}, nil
+	}
+
+	rv := []byte{errNone, 0, 0}
+	var err error
+
+	switch req {
+	case cmdGetCapabilities:
+		data[0] = f.caps
+
+	case cmdSpiPgmEnable:
+		if !f.spiMode && !f.dwDisabled {
+			return []byte{errSpiPgmEnable, 0, 0}, nil
+		}
+		f.spiEnabled = true
+
+	case cmdSpiPgmDisable:
+		f.spiEnabled = false
+
+	case cmdSpiCommand:
+		if !f.spiEnabled {
+			return []byte{errSpiPgmEnable, 0, 0}, nil
+		}
+		c := []byte{byte(val >> 8), byte(val), byte(idx >> 8), byte(idx)}
+		err = f.spi.Command(c, data)
+
+	case cmdSpiReset:
+		f.spiEnabled = false
+		f.spiMode, err = f.dwenUnprogrammed()
+		if err == nil {
+			err = f.target.Reset()
+		}
+
+	case cmdDetectBaudrate:
+		f.spiMode, err = f.dwenUnprogrammed()
+		if err == nil && (f.spiMode || f.dwDisabled) {
+			rv = []byte{errBaudrateDetection, 0, 0}
+		}
+
+	case cmdGetBaudrate:
+		// 16MHz ICE and target, 125000 bps.
+		copy(data, []byte{16, 8, 0, 128, 0, 15})
+
+	case cmdDisable:
+		f.dwDisabled = true
+
+	case cmdReset:
+		err = f.target.Reset()
+
+	case cmdReadSignature:
+		var sign uint16
+		sign, err = f.target.ReadSignature()
+		data[0] = byte(sign >> 8)
+		data[1] = byte(sign)
+
+	case cmdSendBreak:
+		err = f.target.SendBreak()
+
+	case cmdRecvBreak:
+		err = f.target.RecvBreak()
+
+	case cmdGo:
+		err = f.target.Go()
+
+	case cmdStep:
+		err = f.target.Step()
+
+	case cmdContinue:
+		err = f.target.Continue(val, idx&(1<<0) != 0, idx&(1<<1) != 0)
+
+	case cmdWait:
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		c := make(chan bool, 1)
+		err = f.target.Wait(ctx, c)
+		cancel()
+		data[0] = 0
+		select {
+		case <-c:
+			data[0] = 1
+		default:
+		}
+
+	case cmdWaitHalt:
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(val)*time.Millisecond)
+		c := make(chan bool, 1)
+		err = f.target.Wait(ctx, c)
+		cancel()
+		data[0] = 0
+		select {
+		case <-c:
+			data[0] = 1
+		default:
+		}
+
+	case cmdWriteInstruction:
+		err = f.target.WriteInstruction(val)
+
+	case cmdSetPC:
+		err = f.target.SetPC(val)
+
+	case cmdGetPC:
+		var pc uint16
+		pc, err = f.target.GetPC()
+		data[0] = byte(pc >> 8)
+		data[1] = byte(pc)
+
+	case cmdRegisters:
+		if dir == usbfs.DirectionIn {
+			err = f.target.ReadRegisters(byte(val), data)
+		} else {
+			err = f.target.WriteRegisters(byte(val), data)
+		}
+
+	case cmdSRAM:
+		if dir == usbfs.DirectionIn {
+			err = f.target.ReadSRAM(val, data)
+		} else {
+			err = f.target.WriteSRAM(val, data)
+		}
+
+	case cmdReadFlash:
+		err = f.target.ReadFlash(val, data)
+
+	case cmdWriteFlashPage:
+		err = f.target.WriteFlashPage(val, data)
+
+	case cmdEraseFlashPage:
+		err = f.target.EraseFlashPage(val)
+
+	case cmdReadFuses:
+		var fuses []byte
+		fuses, err = f.target.ReadFuses()
+		copy(data, fuses)
+
+	default:
+		rv = []byte{errUnsupported, 0, 0}
+	}
+
+	return rv, err
+}
+
+func (f *FakeIce) mcu() (*devices.MCU, error) {
+	sign, err := f.target.ReadSignature()
+	if err != nil {
+		return nil, err
+	}
+	return devices.GetBySignature(sign)
+}
+
+func (f *FakeIce) dwenUnprogrammed() (bool, error) {
+	mcu, err := f.mcu()
+	if err != nil {
+		return false, err
+	}
+	fuses, err := f.target.ReadFuses()
+	if err != nil {
+		return false, err
+	}
+	return fuses[avr.HIGH_FUSE]&mcu.DWENMask() != 0, nil
+}
@@ -0,0 +1,41 @@
+package dwtkice
+
+type Info struct {
+	Serial  string
+	Version string
+	UsbPath string
+	Spi     bool
+	Err     error
+}
+
+// List returns all the dwtk-ice devices attached to the host.
+func List() ([]*Info, error) {
+	devices, err := listUsbDevices("")
+	if err != nil {
+		return nil, err
+	}
+
+	rv := []*Info{}
+	for _, d := range devices {
+		info := &Info{
+			Serial:  d.serial,
+			UsbPath: d.usbPath,
+		}
+		rv = append(rv, info)
+
+		if err := d.dev.Open(); err != nil {
+			info.Err = err
+			continue
+		}
+		dev, err := newDeviceWithTransport(&usbTransport{dev: d.dev})
+		if err != nil {
+			info.Err = err
+			d.dev.Close()
+			continue
+		}
+		info.Version = dev.getVersion()
+		info.Spi = dev.spi
+		dev.close()
+	}
+	return rv, nil
+}
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/dwtk/devices"
 	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 )
 
 type spiCommands struct {
@@ -39,8 +39,10 @@ func (spi *spiCommands) command(c []byte) ([]byte, error) {
 }
 
 func (spi *spiCommands) chipErase() error {
-	_, err := spi.command(avr.SpiChipErase())
-	return err
+	if _, err := spi.command(avr.SpiChipErase()); err != nil {
+		return err
+	}
+	return spi.waitWrite()
 }
 
 func (spi *spiCommands) waitWrite() error {
@@ -179,7 +181,7 @@ func (spi *spiCommands) writeLock(l byte) error {
 	return nil
 }
 
-func (spi *spiCommands) dwEnable(mcu *devices.MCU) error {
+func (spi *spiCommands) dwEnable(mcu common.MCU) error {
 	if mcu == nil {
 		return errors.New("debugwire: dwtk-ice: mcu not set")
 	}
@@ -191,7 +193,7 @@ func (spi *spiCommands) dwEnable(mcu *devices.MCU) error {
 	return spi.writeHFuse(f)
 }
 
-func (spi *spiCommands) dwDisable(mcu *devices.MCU) error {
+func (spi *spiCommands) dwDisable(mcu common.MCU) error {
 	if mcu == nil {
 		return errors.New("debugwire: dwtk-ice: mcu not set")
 	}
@@ -205,3 +207,71 @@ func (spi *spiCommands) dwDisable(mcu *devices.MCU) error {
 	f |= mcu.DWENMask()
 	return spi.writeHFuse(f)
 }
+
+func (spi *spiCommands) readFlash(start uint16, data []byte) error {
+	for i := range data {
+		addr := start + uint16(i)
+		c := avr.SpiReadProgramMemoryLow(addr / 2)
+		if addr%2 != 0 {
+			c = avr.SpiReadProgramMemoryHigh(addr / 2)
+		}
+		b, err := spi.command(c)
+		if err != nil {
+			return err
+		}
+		data[i] = b[3]
+	}
+	return nil
+}
+
+// writeFlashPage can't erase the page before writing, the flash must be
+// erased with a chip erase first.
+func (spi *spiCommands) writeFlashPage(start uint16, data []byte) error {
+	if len(data)%2 != 0 {
+		return fmt.Errorf("debugwire: dwtk-ice: flash page size must be even: %d", len(data))
+	}
+	for i := 0; i < len(data); i += 2 {
+		addr := (start + uint16(i)) / 2
+		if _, err := spi.command(avr.SpiLoadProgramMemoryPageLow(addr, data[i])); err != nil {
+			return err
+		}
+		if _, err := spi.command(avr.SpiLoadProgramMemoryPageHigh(addr, data[i+1])); err != nil {
+			return err
+		}
+	}
+	if _, err := spi.command(avr.SpiWriteProgramMemoryPage(start / 2)); err != nil {
+		return err
+	}
+	return spi.waitWrite()
+}
+
+func (spi *spiCommands) readEEPROM(start uint16, data []byte) error {
+	for i := range data {
+		b, err := spi.command(avr.SpiReadEEPROM(start + uint16(i)))
+		if err != nil {
+			return err
+		}
+		data[i] = b[3]
+	}
+	return nil
+}
+
+func (spi *spiCommands) writeEEPROM(start uint16, data []byte) error {
+	for i, d := range data {
+		addr := start + uint16(i)
+		b, err := spi.command(avr.SpiReadEEPROM(addr))
+		if err != nil {
+			return err
+		}
+		if b[3] == d { // do not write unless needed
+			continue
+		}
+		if _, err := spi.command(avr.SpiWriteEEPROM(addr, d)); err != nil {
+			return err
+		}
+		if err := spi.waitWrite(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
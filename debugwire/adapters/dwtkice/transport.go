@@ -0,0 +1,36 @@
+package dwtkice
+
+import (
+	"time"
+
+	"github.com/rafaelmartins/usbfs"
+)
+
+// Transport is the USB layer used to talk to a dwtk-ice. It only needs to
+// support vendor control transfers addressed to the device.
+type Transport interface {
+	Control(dir usbfs.Direction, req byte, val uint16, idx uint16, data []byte) error
+	BcdDevice() (uint16, error)
+	Serial() (string, error)
+	Close() error
+}
+
+type usbTransport struct {
+	dev *usbfs.Device
+}
+
+func (t *usbTransport) Control(dir usbfs.Direction, req byte, val uint16, idx uint16, data []byte) error {
+	return t.dev.Control(usbfs.RequestTypeVendor, usbfs.RequestRecipientDevice, dir, req, val, idx, data, 5*time.Second)
+}
+
+func (t *usbTransport) BcdDevice() (uint16, error) {
+	return t.dev.BcdDevice()
+}
+
+func (t *usbTransport) Serial() (string, error) {
+	return t.dev.Serial()
+}
+
+func (t *usbTransport) Close() error {
+	return t.dev.Close()
+}
@@ -5,6 +5,11 @@ import (
 	"time"
 )
 
+// maximum time the dwtk-ice holds a cmdWaitHalt request before answering
+// that the target is still running. it is also the maximum latency to cancel
+// a Wait.
+const waitHaltTimeout = 250 * time.Millisecond
+
 func (dw *DwtkIceAdapter) Wait(ctx context.Context, c chan bool) error {
 	f := make([]byte, 1)
 
@@ -15,8 +20,16 @@ func (dw *DwtkIceAdapter) Wait(ctx context.Context, c chan bool) error {
 		default:
 		}
 
-		if err := dw.dev.controlIn(cmdWait, 0, 0, f); err != nil {
-			return err
+		if dw.dev.waitHalt {
+			// the dwtk-ice answers as soon as the target halts, or after the
+			// timeout.
+			if err := dw.dev.controlIn(cmdWaitHalt, uint16(waitHaltTimeout/time.Millisecond), 0, f); err != nil {
+				return err
+			}
+		} else {
+			if err := dw.dev.controlIn(cmdWait, 0, 0, f); err != nil {
+				return err
+			}
 		}
 
 		if f[0] != 0 {
@@ -24,7 +37,13 @@ func (dw *DwtkIceAdapter) Wait(ctx context.Context, c chan bool) error {
 			break
 		}
 
-		time.Sleep(100 * time.Millisecond)
+		if !dw.dev.waitHalt {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(100 * time.Millisecond):
+			}
+		}
 	}
 
 	return nil
@@ -0,0 +1,305 @@
+package adapters
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+	"github.com/dwtk/dwtk/internal/logger"
+)
+
+type Recorder struct {
+	adapter Adapter
+	fp      *os.File
+	enc     *json.Encoder
+	mutex   *sync.Mutex
+	err     error
+}
+
+func NewRecorder(adapter Adapter, path string) (*Recorder, error) {
+	fp, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug.Printf(" * Recording adapter transcript to %s", path)
+
+	return &Recorder{
+		adapter: adapter,
+		fp:      fp,
+		enc:     json.NewEncoder(fp),
+		mutex:   &sync.Mutex{},
+	}, nil
+}
+
+func (r *Recorder) record(e *transcriptEntry, start time.Time, err error) error {
+	e.Elapsed = time.Since(start)
+	if err != nil {
+		e.Error = err.Error()
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if errw := r.enc.Encode(e); errw != nil && r.err == nil {
+		r.err = errw
+	}
+	return err
+}
+
+func (r *Recorder) Close() error {
+	t := time.Now()
+	err := r.record(&transcriptEntry{Method: "Close"}, t, r.adapter.Close())
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if errc := r.fp.Close(); errc != nil && r.err == nil {
+		r.err = errc
+	}
+	if err != nil {
+		return err
+	}
+	return r.err
+}
+
+func (r *Recorder) Info() string {
+	t := time.Now()
+	rv := r.adapter.Info()
+	r.record(&transcriptEntry{Method: "Info", Text: rv}, t, nil)
+	return rv
+}
+
+// ID is not recorded, as it doesn't talk to the adapter.
+func (r *Recorder) ID() string {
+	return r.adapter.ID()
+}
+
+func (r *Recorder) Capabilities() common.Capabilities {
+	t := time.Now()
+	rv := r.adapter.Capabilities()
+	r.record(&transcriptEntry{Method: "Capabilities", Value: uint16(rv)}, t, nil)
+	return rv
+}
+
+func (r *Recorder) SetMCU(mcu common.MCU) {
+	r.adapter.SetMCU(mcu)
+}
+
+func (r *Recorder) GetMCU() common.MCU {
+	return r.adapter.GetMCU()
+}
+
+func (r *Recorder) Enable() error {
+	t := time.Now()
+	return r.record(&transcriptEntry{Method: "Enable"}, t, r.adapter.Enable())
+}
+
+func (r *Recorder) Disable() error {
+	t := time.Now()
+	return r.record(&transcriptEntry{Method: "Disable"}, t, r.adapter.Disable())
+}
+
+func (r *Recorder) SwitchMode(debugWIRE bool) error {
+	t := time.Now()
+	return r.record(&transcriptEntry{Method: "SwitchMode", Args: transcriptArgs(debugWIRE)}, t, r.adapter.SwitchMode(debugWIRE))
+}
+
+func (r *Recorder) Reset() error {
+	t := time.Now()
+	return r.record(&transcriptEntry{Method: "Reset"}, t, r.adapter.Reset())
+}
+
+func (r *Recorder) ReadSignature() (uint16, error) {
+	t := time.Now()
+	rv, err := r.adapter.ReadSignature()
+	return rv, r.record(&transcriptEntry{Method: "ReadSignature", Value: rv}, t, err)
+}
+
+func (r *Recorder) ChipErase() error {
+	t := time.Now()
+	return r.record(&transcriptEntry{Method: "ChipErase"}, t, r.adapter.ChipErase())
+}
+
+func (r *Recorder) SendBreak() error {
+	t := time.Now()
+	return r.record(&transcriptEntry{Method: "SendBreak"}, t, r.adapter.SendBreak())
+}
+
+func (r *Recorder) RecvBreak() error {
+	t := time.Now()
+	return r.record(&transcriptEntry{Method: "RecvBreak"}, t, r.adapter.RecvBreak())
+}
+
+func (r *Recorder) Go() error {
+	t := time.Now()
+	return r.record(&transcriptEntry{Method: "Go"}, t, r.adapter.Go())
+}
+
+func (r *Recorder) ResetAndGo() error {
+	t := time.Now()
+	return r.record(&transcriptEntry{Method: "ResetAndGo"}, t, r.adapter.ResetAndGo())
+}
+
+func (r *Recorder) Step() error {
+	t := time.Now()
+	return r.record(&transcriptEntry{Method: "Step"}, t, r.adapter.Step())
+}
+
+func (r *Recorder) Continue(hwBreakpoint uint16, hwBreakpointSet bool, timers bool) error {
+	t := time.Now()
+	err := r.adapter.Continue(hwBreakpoint, hwBreakpointSet, timers)
+	return r.record(&transcriptEntry{
+		Method: "Continue",
+		Args:   transcriptArgs(hwBreakpoint, hwBreakpointSet, timers),
+	}, t, err)
+}
+
+func (r *Recorder) Wait(ctx context.Context, c chan bool) error {
+	t := time.Now()
+
+	// we need to know if the adapter signaled a halt, so we proxy the channel.
+	signaled := false
+	p := make(chan bool)
+	wctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		select {
+		case <-wctx.Done():
+		case v := <-p:
+			signaled = true
+			select {
+			case <-ctx.Done():
+			case c <- v:
+			}
+		}
+	}()
+
+	err := r.adapter.Wait(wctx, p)
+	cancel()
+	<-done
+
+	e := &transcriptEntry{Method: "Wait"}
+	if signaled {
+		e.Value = 1
+	}
+	return r.record(e, t, err)
+}
+
+func (r *Recorder) WriteInstruction(inst uint16) error {
+	t := time.Now()
+	err := r.adapter.WriteInstruction(inst)
+	return r.record(&transcriptEntry{Method: "WriteInstruction", Args: transcriptArgs(inst)}, t, err)
+}
+
+func (r *Recorder) SetPC(pc uint16) error {
+	t := time.Now()
+	return r.record(&transcriptEntry{Method: "SetPC", Args: transcriptArgs(pc)}, t, r.adapter.SetPC(pc))
+}
+
+func (r *Recorder) GetPC() (uint16, error) {
+	t := time.Now()
+	rv, err := r.adapter.GetPC()
+	return rv, r.record(&transcriptEntry{Method: "GetPC", Value: rv}, t, err)
+}
+
+func (r *Recorder) WriteRegisters(start byte, regs []byte) error {
+	t := time.Now()
+	err := r.adapter.WriteRegisters(start, regs)
+	return r.record(&transcriptEntry{Method: "WriteRegisters", Args: transcriptArgs(start, regs)}, t, err)
+}
+
+func (r *Recorder) ReadRegisters(start byte, regs []byte) error {
+	t := time.Now()
+	err := r.adapter.ReadRegisters(start, regs)
+	return r.record(&transcriptEntry{
+		Method: "ReadRegisters",
+		Args:   transcriptArgs(start, len(regs)),
+		Data:   hex.EncodeToString(regs),
+	}, t, err)
+}
+
+func (r *Recorder) WriteSRAM(start uint16, data []byte) error {
+	t := time.Now()
+	err := r.adapter.WriteSRAM(start, data)
+	return r.record(&transcriptEntry{Method: "WriteSRAM", Args: transcriptArgs(start, data)}, t, err)
+}
+
+func (r *Recorder) ReadSRAM(start uint16, data []byte) error {
+	t := time.Now()
+	err := r.adapter.ReadSRAM(start, data)
+	return r.record(&transcriptEntry{
+		Method: "ReadSRAM",
+		Args:   transcriptArgs(start, len(data)),
+		Data:   hex.EncodeToString(data),
+	}, t, err)
+}
+
+func (r *Recorder) ReadFlash(start uint16, data []byte) error {
+	t := time.Now()
+	err := r.adapter.ReadFlash(start, data)
+	return r.record(&transcriptEntry{
+		Method: "ReadFlash",
+		Args:   transcriptArgs(start, len(data)),
+		Data:   hex.EncodeToString(data),
+	}, t, err)
+}
+
+func (r *Recorder) WriteFlashPage(start uint16, data []byte) error {
+	t := time.Now()
+	err := r.adapter.WriteFlashPage(start, data)
+	return r.record(&transcriptEntry{Method: "WriteFlashPage", Args: transcriptArgs(start, data)}, t, err)
+}
+
+func (r *Recorder) EraseFlashPage(start uint16) error {
+	t := time.Now()
+	err := r.adapter.EraseFlashPage(start)
+	return r.record(&transcriptEntry{Method: "EraseFlashPage", Args: transcriptArgs(start)}, t, err)
+}
+
+func (r *Recorder) ReadEEPROM(start uint16, data []byte) error {
+	t := time.Now()
+	err := r.adapter.ReadEEPROM(start, data)
+	return r.record(&transcriptEntry{
+		Method: "ReadEEPROM",
+		Args:   transcriptArgs(start, len(data)),
+		Data:   hex.EncodeToString(data),
+	}, t, err)
+}
+
+func (r *Recorder) WriteEEPROM(start uint16, data []byte) error {
+	t := time.Now()
+	err := r.adapter.WriteEEPROM(start, data)
+	return r.record(&transcriptEntry{Method: "WriteEEPROM", Args: transcriptArgs(start, data)}, t, err)
+}
+
+func (r *Recorder) ReadFuses() ([]byte, error) {
+	t := time.Now()
+	rv, err := r.adapter.ReadFuses()
+	return rv, r.record(&transcriptEntry{Method: "ReadFuses", Data: hex.EncodeToString(rv)}, t, err)
+}
+
+func (r *Recorder) WriteLFuse(data byte) error {
+	t := time.Now()
+	return r.record(&transcriptEntry{Method: "WriteLFuse", Args: transcriptArgs(data)}, t, r.adapter.WriteLFuse(data))
+}
+
+func (r *Recorder) WriteHFuse(data byte) error {
+	t := time.Now()
+	return r.record(&transcriptEntry{Method: "WriteHFuse", Args: transcriptArgs(data)}, t, r.adapter.WriteHFuse(data))
+}
+
+func (r *Recorder) WriteEFuse(data byte) error {
+	t := time.Now()
+	return r.record(&transcriptEntry{Method: "WriteEFuse", Args: transcriptArgs(data)}, t, r.adapter.WriteEFuse(data))
+}
+
+func (r *Recorder) WriteLock(data byte) error {
+	t := time.Now()
+	return r.record(&transcriptEntry{Method: "WriteLock", Args: transcriptArgs(data)}, t, r.adapter.WriteLock(data))
+}
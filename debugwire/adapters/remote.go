@@ -0,0 +1,428 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+	"github.com/dwtk/dwtk/internal/logger"
+)
+
+// The remote adapter protocol is line-delimited JSON over TCP.
+//
+// Right after accepting a connection, the server opens its local adapter and
+// sends {"event": "hello", "text": "<adapter info>"}, or {"event": "hello",
+// "error": "<message>"} if the adapter could not be opened.
+//
+// The client then sends requests, named after the Adapter methods, e.g.
+// {"id": 1, "method": "ReadSRAM", "value": 256, "length": 16}, and the server
+// answers each of them with a response carrying the same id, the results and
+// an "error" string if the call failed, e.g. {"id": 1, "data": "AAECAw=="}.
+// Arguments and results use these fields:
+//
+//	value:  addresses, program counter, instructions, fuses, signature
+//	        (SetMCU, ReadSignature) and capabilities.
+//	length: number of bytes to read.
+//	data:   bytes read or written, base64-encoded.
+//	bp_set: hardware breakpoint set (Continue) and debugWIRE mode
+//	        (SwitchMode).
+//	timers: run timers (Continue).
+//	text:   adapter information (Info) and identity (ID).
+//
+// Requests are handled in order, except for "Wait", that runs in background
+// until the target halts or the client sends {"id": <wait id>, "method":
+// "Cancel"}. As soon as the target halts, the server sends {"id": <wait id>,
+// "event": "halt"}. The response to "Wait" is sent after it returns.
+//
+// "Close" closes the adapter and the connection.
+type remoteMessage struct {
+	ID     uint32 `json:"id"`
+	Method string `json:"method,omitempty"`
+	Event  string `json:"event,omitempty"`
+	Value  uint16 `json:"value,omitempty"`
+	Length int    `json:"length,omitempty"`
+	Data   []byte `json:"data,omitempty"`
+	BpSet  bool   `json:"bp_set,omitempty"`
+	Timers bool   `json:"timers,omitempty"`
+	Text   string `json:"text,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+var errRemoteClosed = errors.New("debugwire: remote: connection closed")
+
+type Remote struct {
+	conn    net.Conn
+	enc     *json.Encoder
+	addr    string
+	info    string
+	mcu     common.MCU
+	id      uint32
+	pending map[uint32]chan *remoteMessage
+	events  map[uint32]chan struct{}
+	mutex   *sync.Mutex
+	wmutex  *sync.Mutex
+	err     error
+}
+
+func NewRemote(addr string) (*Remote, error) {
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(conn)
+	hello := &remoteMessage{}
+	if err := dec.Decode(hello); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("debugwire: remote: failed to read hello: %s", err)
+	}
+	if hello.Event != "hello" {
+		conn.Close()
+		return nil, fmt.Errorf("debugwire: remote: expected hello, got %q", hello.Event)
+	}
+	if hello.Error != "" {
+		conn.Close()
+		return nil, fmt.Errorf("debugwire: remote: %s", hello.Error)
+	}
+
+	logger.Debug.Printf(" * Connected to remote adapter at %s", addr)
+
+	rv := &Remote{
+		conn:    conn,
+		enc:     json.NewEncoder(conn),
+		addr:    addr,
+		info:    hello.Text,
+		pending: map[uint32]chan *remoteMessage{},
+		events:  map[uint32]chan struct{}{},
+		mutex:   &sync.Mutex{},
+		wmutex:  &sync.Mutex{},
+	}
+	go rv.recv(dec)
+	return rv, nil
+}
+
+func (r *Remote) recv(dec *json.Decoder) {
+	for {
+		m := &remoteMessage{}
+		if err := dec.Decode(m); err != nil {
+			r.mutex.Lock()
+			r.err = err
+			for id, c := range r.pending {
+				close(c)
+				delete(r.pending, id)
+			}
+			r.mutex.Unlock()
+			return
+		}
+
+		r.mutex.Lock()
+		if m.Event == "halt" {
+			if c, ok := r.events[m.ID]; ok {
+				select {
+				case c <- struct{}{}:
+				default:
+				}
+			}
+		} else if c, ok := r.pending[m.ID]; ok {
+			c <- m
+			delete(r.pending, m.ID)
+		}
+		r.mutex.Unlock()
+	}
+}
+
+func (r *Remote) send(m *remoteMessage) error {
+	r.wmutex.Lock()
+	defer r.wmutex.Unlock()
+
+	logger.Debug.Printf(">>> remote: %d %s", m.ID, m.Method)
+	return r.enc.Encode(m)
+}
+
+// start sends a request. if halt is not nil, it gets the halt events for the
+// request.
+func (r *Remote) start(m *remoteMessage, halt chan struct{}) (chan *remoteMessage, error) {
+	r.mutex.Lock()
+	if r.err != nil {
+		r.mutex.Unlock()
+		return nil, errRemoteClosed
+	}
+	r.id++
+	m.ID = r.id
+	c := make(chan *remoteMessage, 1)
+	r.pending[m.ID] = c
+	if halt != nil {
+		r.events[m.ID] = halt
+	}
+	r.mutex.Unlock()
+
+	if err := r.send(m); err != nil {
+		r.done(m.ID)
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *Remote) done(id uint32) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.pending, id)
+	delete(r.events, id)
+}
+
+func (r *Remote) call(m *remoteMessage) (*remoteMessage, error) {
+	c, err := r.start(m, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.response(c)
+}
+
+func (r *Remote) response(c chan *remoteMessage) (*remoteMessage, error) {
+	rv, ok := <-c
+	if !ok {
+		return nil, errRemoteClosed
+	}
+	if rv.Error != "" {
+		return rv, errors.New(rv.Error)
+	}
+	return rv, nil
+}
+
+func (r *Remote) simple(method string, value uint16) error {
+	_, err := r.call(&remoteMessage{Method: method, Value: value})
+	return err
+}
+
+func (r *Remote) readData(method string, start uint16, data []byte) error {
+	rv, err := r.call(&remoteMessage{Method: method, Value: start, Length: len(data)})
+	if err != nil {
+		return err
+	}
+	if len(rv.Data) != len(data) {
+		return fmt.Errorf("debugwire: remote: %s: expected %d bytes, got %d", method, len(data), len(rv.Data))
+	}
+	copy(data, rv.Data)
+	return nil
+}
+
+func (r *Remote) Close() error {
+	err := r.simple("Close", 0)
+	if errc := r.conn.Close(); err == nil {
+		err = errc
+	}
+	return err
+}
+
+func (r *Remote) Info() string {
+	return r.info
+}
+
+// ID identifies the remote adapter by the server address, as the ports of
+// different hosts may have the same name.
+func (r *Remote) ID() string {
+	rv, err := r.call(&remoteMessage{Method: "ID"})
+	if err != nil {
+		return "remote " + r.addr
+	}
+	return "remote " + r.addr + " " + rv.Text
+}
+
+func (r *Remote) Capabilities() common.Capabilities {
+	rv, err := r.call(&remoteMessage{Method: "Capabilities"})
+	if err != nil {
+		return 0
+	}
+	return common.Capabilities(rv.Value)
+}
+
+func (r *Remote) SetMCU(mcu common.MCU) {
+	r.mcu = mcu
+	if mcu != nil {
+		r.simple("SetMCU", mcu.Signature())
+	}
+}
+
+func (r *Remote) GetMCU() common.MCU {
+	return r.mcu
+}
+
+func (r *Remote) Enable() error {
+	return r.simple("Enable", 0)
+}
+
+func (r *Remote) Disable() error {
+	return r.simple("Disable", 0)
+}
+
+func (r *Remote) SwitchMode(debugWIRE bool) error {
+	_, err := r.call(&remoteMessage{Method: "SwitchMode", BpSet: debugWIRE})
+	return err
+}
+
+func (r *Remote) Reset() error {
+	return r.simple("Reset", 0)
+}
+
+func (r *Remote) ReadSignature() (uint16, error) {
+	rv, err := r.call(&remoteMessage{Method: "ReadSignature"})
+	if err != nil {
+		return 0, err
+	}
+	return rv.Value, nil
+}
+
+func (r *Remote) ChipErase() error {
+	return r.simple("ChipErase", 0)
+}
+
+func (r *Remote) SendBreak() error {
+	return r.simple("SendBreak", 0)
+}
+
+func (r *Remote) RecvBreak() error {
+	return r.simple("RecvBreak", 0)
+}
+
+func (r *Remote) Go() error {
+	return r.simple("Go", 0)
+}
+
+func (r *Remote) ResetAndGo() error {
+	return r.simple("ResetAndGo", 0)
+}
+
+func (r *Remote) Step() error {
+	return r.simple("Step", 0)
+}
+
+func (r *Remote) Continue(hwBreakpoint uint16, hwBreakpointSet bool, timers bool) error {
+	_, err := r.call(&remoteMessage{
+		Method: "Continue",
+		Value:  hwBreakpoint,
+		BpSet:  hwBreakpointSet,
+		Timers: timers,
+	})
+	return err
+}
+
+func (r *Remote) Wait(ctx context.Context, c chan bool) error {
+	m := &remoteMessage{Method: "Wait"}
+	halt := make(chan struct{}, 1)
+	resp, err := r.start(m, halt)
+	if err != nil {
+		return err
+	}
+	defer r.done(m.ID)
+
+	done := ctx.Done()
+	for {
+		select {
+		case <-halt:
+			select {
+			case <-ctx.Done():
+			case c <- true:
+			}
+
+		case <-done:
+			done = nil
+			if err := r.send(&remoteMessage{ID: m.ID, Method: "Cancel"}); err != nil {
+				return err
+			}
+
+		case rv, ok := <-resp:
+			if !ok {
+				return errRemoteClosed
+			}
+			if rv.Error != "" {
+				return errors.New(rv.Error)
+			}
+			return nil
+		}
+	}
+}
+
+func (r *Remote) WriteInstruction(inst uint16) error {
+	return r.simple("WriteInstruction", inst)
+}
+
+func (r *Remote) SetPC(pc uint16) error {
+	return r.simple("SetPC", pc)
+}
+
+func (r *Remote) GetPC() (uint16, error) {
+	rv, err := r.call(&remoteMessage{Method: "GetPC"})
+	if err != nil {
+		return 0, err
+	}
+	return rv.Value, nil
+}
+
+func (r *Remote) WriteRegisters(start byte, regs []byte) error {
+	_, err := r.call(&remoteMessage{Method: "WriteRegisters", Value: uint16(start), Data: regs})
+	return err
+}
+
+func (r *Remote) ReadRegisters(start byte, regs []byte) error {
+	return r.readData("ReadRegisters", uint16(start), regs)
+}
+
+func (r *Remote) WriteSRAM(start uint16, data []byte) error {
+	_, err := r.call(&remoteMessage{Method: "WriteSRAM", Value: start, Data: data})
+	return err
+}
+
+func (r *Remote) ReadSRAM(start uint16, data []byte) error {
+	return r.readData("ReadSRAM", start, data)
+}
+
+func (r *Remote) ReadFlash(start uint16, data []byte) error {
+	return r.readData("ReadFlash", start, data)
+}
+
+func (r *Remote) WriteFlashPage(start uint16, data []byte) error {
+	_, err := r.call(&remoteMessage{Method: "WriteFlashPage", Value: start, Data: data})
+	return err
+}
+
+func (r *Remote) EraseFlashPage(start uint16) error {
+	return r.simple("EraseFlashPage", start)
+}
+
+func (r *Remote) ReadEEPROM(start uint16, data []byte) error {
+	return r.readData("ReadEEPROM", start, data)
+}
+
+func (r *Remote) WriteEEPROM(start uint16, data []byte) error {
+	_, err := r.call(&remoteMessage{Method: "WriteEEPROM", Value: start, Data: data})
+	return err
+}
+
+func (r *Remote) ReadFuses() ([]byte, error) {
+	rv, err := r.call(&remoteMessage{Method: "ReadFuses"})
+	if err != nil {
+		return nil, err
+	}
+	return rv.Data, nil
+}
+
+func (r *Remote) WriteLFuse(data byte) error {
+	return r.simple("WriteLFuse", uint16(data))
+}
+
+func (r *Remote) WriteHFuse(data byte) error {
+	return r.simple("WriteHFuse", uint16(data))
+}
+
+func (r *Remote) WriteEFuse(data byte) error {
+	return r.simple("WriteEFuse", uint16(data))
+}
+
+func (r *Remote) WriteLock(data byte) error {
+	return r.simple("WriteLock", uint16(data))
+}
@@ -0,0 +1,200 @@
+package adapters
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/dwtk/dwtk/debugwire/adapters/sim"
+	"github.com/dwtk/dwtk/internal/adaptertest"
+)
+
+// serveSim serves a new simulated target to each client, until the returned
+// function is called.
+func serveSim(t *testing.T) (string, func()) {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- Serve(ctx, l, func() (Adapter, error) {
+			return sim.New("atmega328p")
+		})
+	}()
+
+	return l.Addr().String(), func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func newTestRemote(t *testing.T, addr string) *Remote {
+	t.Helper()
+
+	r, err := NewRemote(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sign, err := r.ReadSignature()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mcu, err := GetMCUBySignature(sign)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.SetMCU(mcu)
+	return r
+}
+
+func TestRemoteConformance(t *testing.T) {
+	addr, cleanup := serveSim(t)
+	defer cleanup()
+
+	adaptertest.Run(t, func(t *testing.T) (adaptertest.Adapter, func()) {
+		r := newTestRemote(t, addr)
+		return r, func() {
+			r.Close()
+		}
+	})
+}
+
+// rawClient sends requests to the server without the checks done by Remote.
+type rawClient struct {
+	conn net.Conn
+	dec  *json.Decoder
+}
+
+func newRawClient(t *testing.T, addr string) *rawClient {
+	t.Helper()
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rv := &rawClient{
+		conn: conn,
+		dec:  json.NewDecoder(bufio.NewReader(conn)),
+	}
+	if m := rv.recv(t); m.Event != "hello" {
+		t.Fatalf("expected hello: %+v", m)
+	}
+	return rv
+}
+
+func (c *rawClient) recv(t *testing.T) *remoteMessage {
+	t.Helper()
+
+	m := &remoteMessage{}
+	if err := c.dec.Decode(m); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func (c *rawClient) call(t *testing.T, req string) *remoteMessage {
+	t.Helper()
+
+	if _, err := c.conn.Write([]byte(req + "\n")); err != nil {
+		t.Fatal(err)
+	}
+	return c.recv(t)
+}
+
+func TestServerMalformedRequests(t *testing.T) {
+	addr, cleanup := serveSim(t)
+	defer cleanup()
+
+	c := newRawClient(t, addr)
+
+	for _, req := range []string{
+		`{"id": 1, "method": "ReadFlash", "value": 0, "length": 2}`,
+		`{"id": 1, "method": "ReadEEPROM", "value": 0, "length": 2}`,
+		`{"id": 1, "method": "Unknown"}`,
+	} {
+		if m := c.call(t, req); m.Error == "" {
+			t.Fatalf("invalid request succeeded: %s", req)
+		}
+	}
+
+	if m := c.call(t, `{"id": 2, "method": "SetMCU", "value": 38159}`); m.Error != "" {
+		t.Fatal(m.Error)
+	}
+
+	for _, req := range []string{
+		`{"id": 3, "method": "ReadRegisters", "value": 0, "length": -1}`,
+		`{"id": 3, "method": "ReadRegisters", "value": 30, "length": 3}`,
+		`{"id": 3, "method": "ReadRegisters", "value": 256, "length": 1}`,
+		`{"id": 3, "method": "ReadSRAM", "value": 256, "length": -1}`,
+		`{"id": 3, "method": "ReadSRAM", "value": 256, "length": 1099511627776}`,
+		`{"id": 3, "method": "ReadSRAM", "value": 65535, "length": 2}`,
+		`{"id": 3, "method": "ReadFlash", "value": 32767, "length": 2}`,
+		`{"id": 3, "method": "ReadFlash", "value": 0, "length": -9223372036854775808}`,
+		`{"id": 3, "method": "ReadEEPROM", "value": 1023, "length": 2}`,
+	} {
+		m := c.call(t, req)
+		if m.Error == "" {
+			t.Fatalf("malformed request succeeded: %s", req)
+		}
+		if m.ID != 3 || len(m.Data) != 0 {
+			t.Fatalf("bad response to %s: %+v", req, m)
+		}
+	}
+
+	// valid requests are still served on the same connection.
+	m := c.call(t, `{"id": 4, "method": "ReadRegisters", "value": 30, "length": 2}`)
+	if m.Error != "" || len(m.Data) != 2 {
+		t.Fatalf("bad response: %+v", m)
+	}
+
+	// invalid JSON drops the client, and the server keeps serving the
+	// next one.
+	if _, err := c.conn.Write([]byte("{\"id\": \n}\n")); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.dec.Decode(&remoteMessage{}); err == nil {
+		t.Fatal("connection not closed")
+	}
+	c.conn.Close()
+
+	r := newTestRemote(t, addr)
+	defer r.Close()
+	if _, err := r.GetPC(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRemoteReadRange(t *testing.T) {
+	addr, cleanup := serveSim(t)
+	defer cleanup()
+
+	r := newTestRemote(t, addr)
+	defer r.Close()
+
+	err := r.ReadFlash(r.GetMCU().FlashSize()-1, make([]byte, 2))
+	if err == nil || !strings.Contains(err.Error(), "invalid range") {
+		t.Fatalf("out of range read: %v", err)
+	}
+}
+
+func TestRemoteID(t *testing.T) {
+	addr, cleanup := serveSim(t)
+	defer cleanup()
+
+	r := newTestRemote(t, addr)
+	defer r.Close()
+
+	if id := r.ID(); id != "remote "+addr+" sim ATmega328P" {
+		t.Fatalf("bad id: %q", id)
+	}
+}
@@ -0,0 +1,276 @@
+package adapters
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+	"github.com/dwtk/dwtk/internal/logger"
+)
+
+type Replayer struct {
+	entries []*transcriptEntry
+	mcu     common.MCU
+	mutex   *sync.Mutex
+}
+
+func NewReplayer(path string) (*Replayer, error) {
+	fp, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fp.Close()
+
+	entries := []*transcriptEntry{}
+	line := 0
+	scanner := bufio.NewScanner(fp)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		e := &transcriptEntry{}
+		if err := json.Unmarshal(scanner.Bytes(), e); err != nil {
+			return nil, fmt.Errorf("debugwire: replay: failed to parse line %d: %s", line, err)
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	logger.Debug.Printf(" * Replaying %d adapter calls from %s", len(entries), path)
+
+	return &Replayer{
+		entries: entries,
+		mutex:   &sync.Mutex{},
+	}, nil
+}
+
+func (r *Replayer) next(method string, args ...interface{}) (*transcriptEntry, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	got := &transcriptEntry{Method: method, Args: transcriptArgs(args...)}
+	if len(r.entries) == 0 {
+		return nil, fmt.Errorf("debugwire: replay: transcript exhausted, got %s", got)
+	}
+
+	e := r.entries[0]
+	if e.Method != method || (len(e.Args) > 0 || len(got.Args) > 0) && !reflect.DeepEqual(e.Args, got.Args) {
+		return nil, fmt.Errorf("debugwire: replay: expected %s, got %s", e, got)
+	}
+
+	r.entries = r.entries[1:]
+	return e, nil
+}
+
+func (r *Replayer) replay(method string, args ...interface{}) error {
+	e, err := r.next(method, args...)
+	if err != nil {
+		return err
+	}
+	return e.err()
+}
+
+func (r *Replayer) replayData(method string, data []byte, args ...interface{}) error {
+	e, err := r.next(method, args...)
+	if err != nil {
+		return err
+	}
+	d, err := e.data()
+	if err != nil {
+		return err
+	}
+	copy(data, d)
+	return e.err()
+}
+
+func (r *Replayer) Close() error {
+	return r.replay("Close")
+}
+
+func (r *Replayer) Info() string {
+	e, err := r.next("Info")
+	if err != nil {
+		return fmt.Sprintf("Error: %s\n", err)
+	}
+	return e.Text
+}
+
+func (r *Replayer) ID() string {
+	return "replay"
+}
+
+func (r *Replayer) Capabilities() common.Capabilities {
+	e, err := r.next("Capabilities")
+	if err != nil {
+		return 0
+	}
+	return common.Capabilities(e.Value)
+}
+
+func (r *Replayer) SetMCU(mcu common.MCU) {
+	r.mcu = mcu
+}
+
+func (r *Replayer) GetMCU() common.MCU {
+	return r.mcu
+}
+
+func (r *Replayer) Enable() error {
+	return r.replay("Enable")
+}
+
+func (r *Replayer) Disable() error {
+	return r.replay("Disable")
+}
+
+func (r *Replayer) SwitchMode(debugWIRE bool) error {
+	return r.replay("SwitchMode", debugWIRE)
+}
+
+func (r *Replayer) Reset() error {
+	return r.replay("Reset")
+}
+
+func (r *Replayer) ReadSignature() (uint16, error) {
+	e, err := r.next("ReadSignature")
+	if err != nil {
+		return 0, err
+	}
+	return e.Value, e.err()
+}
+
+func (r *Replayer) ChipErase() error {
+	return r.replay("ChipErase")
+}
+
+func (r *Replayer) SendBreak() error {
+	return r.replay("SendBreak")
+}
+
+func (r *Replayer) RecvBreak() error {
+	return r.replay("RecvBreak")
+}
+
+func (r *Replayer) Go() error {
+	return r.replay("Go")
+}
+
+func (r *Replayer) ResetAndGo() error {
+	return r.replay("ResetAndGo")
+}
+
+func (r *Replayer) Step() error {
+	return r.replay("Step")
+}
+
+func (r *Replayer) Continue(hwBreakpoint uint16, hwBreakpointSet bool, timers bool) error {
+	return r.replay("Continue", hwBreakpoint, hwBreakpointSet, timers)
+}
+
+func (r *Replayer) Wait(ctx context.Context, c chan bool) error {
+	e, err := r.next("Wait")
+	if err != nil {
+		return err
+	}
+
+	if e.Value == 0 {
+		// the recorded session was interrupted by the user, not by the target.
+		<-ctx.Done()
+		return e.err()
+	}
+
+	select {
+	case <-ctx.Done():
+	case c <- true:
+	}
+	return e.err()
+}
+
+func (r *Replayer) WriteInstruction(inst uint16) error {
+	return r.replay("WriteInstruction", inst)
+}
+
+func (r *Replayer) SetPC(pc uint16) error {
+	return r.replay("SetPC", pc)
+}
+
+func (r *Replayer) GetPC() (uint16, error) {
+	e, err := r.next("GetPC")
+	if err != nil {
+		return 0, err
+	}
+	return e.Value, e.err()
+}
+
+func (r *Replayer) WriteRegisters(start byte, regs []byte) error {
+	return r.replay("WriteRegisters", start, regs)
+}
+
+func (r *Replayer) ReadRegisters(start byte, regs []byte) error {
+	return r.replayData("ReadRegisters", regs, start, len(regs))
+}
+
+func (r *Replayer) WriteSRAM(start uint16, data []byte) error {
+	return r.replay("WriteSRAM", start, data)
+}
+
+func (r *Replayer) ReadSRAM(start uint16, data []byte) error {
+	return r.replayData("ReadSRAM", data, start, len(data))
+}
+
+func (r *Replayer) ReadFlash(start uint16, data []byte) error {
+	return r.replayData("ReadFlash", data, start, len(data))
+}
+
+func (r *Replayer) WriteFlashPage(start uint16, data []byte) error {
+	return r.replay("WriteFlashPage", start, data)
+}
+
+func (r *Replayer) EraseFlashPage(start uint16) error {
+	return r.replay("EraseFlashPage", start)
+}
+
+func (r *Replayer) ReadEEPROM(start uint16, data []byte) error {
+	return r.replayData("ReadEEPROM", data, start, len(data))
+}
+
+func (r *Replayer) WriteEEPROM(start uint16, data []byte) error {
+	return r.replay("WriteEEPROM", start, data)
+}
+
+func (r *Replayer) ReadFuses() ([]byte, error) {
+	e, err := r.next("ReadFuses")
+	if err != nil {
+		return nil, err
+	}
+	d, err := e.data()
+	if err != nil {
+		return nil, err
+	}
+	return d, e.err()
+}
+
+func (r *Replayer) WriteLFuse(data byte) error {
+	return r.replay("WriteLFuse", data)
+}
+
+func (r *Replayer) WriteHFuse(data byte) error {
+	return r.replay("WriteHFuse", data)
+}
+
+func (r *Replayer) WriteEFuse(data byte) error {
+	return r.replay("WriteEFuse", data)
+}
+
+func (r *Replayer) WriteLock(data byte) error {
+	return r.replay("WriteLock", data)
+}
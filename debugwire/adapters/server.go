@@ -0,0 +1,309 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+	"github.com/dwtk/dwtk/internal/logger"
+)
+
+// Serve exposes adapters to remote clients, using the protocol described in
+// remote.go. Clients are served one at a time, and the adapter is opened with
+// open for each of them, to pick up mode changes (e.g. after `dwtk disable`).
+func Serve(ctx context.Context, listener net.Listener, open func() (Adapter, error)) error {
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+			}
+			return err
+		}
+
+		logger.Debug.Printf(" * Accepted remote client %s", conn.RemoteAddr())
+		if err := serveConn(ctx, conn, open); err != nil {
+			logger.Debug.Printf(" * Remote client %s: %s", conn.RemoteAddr(), err)
+		}
+		conn.Close()
+	}
+}
+
+type serverConn struct {
+	adapter Adapter
+	enc     *json.Encoder
+	waits   map[uint32]context.CancelFunc
+	wg      *sync.WaitGroup
+	mutex   *sync.Mutex
+	wmutex  *sync.Mutex
+}
+
+func serveConn(ctx context.Context, conn net.Conn, open func() (Adapter, error)) error {
+	enc := json.NewEncoder(conn)
+
+	adapter, err := open()
+	if err != nil {
+		enc.Encode(&remoteMessage{Event: "hello", Error: err.Error()})
+		return err
+	}
+
+	s := &serverConn{
+		adapter: adapter,
+		enc:     enc,
+		waits:   map[uint32]context.CancelFunc{},
+		wg:      &sync.WaitGroup{},
+		mutex:   &sync.Mutex{},
+		wmutex:  &sync.Mutex{},
+	}
+
+	closed := false
+	defer func() {
+		s.mutex.Lock()
+		for _, cancel := range s.waits {
+			cancel()
+		}
+		s.mutex.Unlock()
+		s.wg.Wait()
+		if !closed {
+			adapter.Close()
+		}
+	}()
+
+	if err := s.send(&remoteMessage{Event: "hello", Text: adapter.Info()}); err != nil {
+		return err
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-stop:
+		}
+	}()
+
+	dec := json.NewDecoder(conn)
+	for {
+		m := &remoteMessage{}
+		if err := dec.Decode(m); err != nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+			}
+			return err
+		}
+		logger.Debug.Printf("<<< remote: %d %s", m.ID, m.Method)
+
+		switch m.Method {
+		case "Wait":
+			s.wait(ctx, m.ID)
+
+		case "Cancel":
+			s.mutex.Lock()
+			if cancel, ok := s.waits[m.ID]; ok {
+				cancel()
+			}
+			s.mutex.Unlock()
+
+		case "Close":
+			closed = true
+			if err := s.send(s.call(m)); err != nil {
+				return err
+			}
+			return nil
+
+		default:
+			if err := s.send(s.call(m)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *serverConn) send(m *remoteMessage) error {
+	s.wmutex.Lock()
+	defer s.wmutex.Unlock()
+
+	return s.enc.Encode(m)
+}
+
+func (s *serverConn) wait(ctx context.Context, id uint32) {
+	wctx, cancel := context.WithCancel(ctx)
+	s.mutex.Lock()
+	s.waits[id] = cancel
+	s.mutex.Unlock()
+
+	c := make(chan bool)
+	forwarded := make(chan struct{})
+	s.wg.Add(1)
+
+	go func() {
+		defer close(forwarded)
+		select {
+		case <-wctx.Done():
+		case <-c:
+			s.send(&remoteMessage{ID: id, Event: "halt"})
+		}
+	}()
+
+	go func() {
+		defer s.wg.Done()
+		rv := &remoteMessage{ID: id}
+		if err := s.adapter.Wait(wctx, c); err != nil {
+			rv.Error = err.Error()
+		}
+		s.mutex.Lock()
+		delete(s.waits, id)
+		s.mutex.Unlock()
+
+		// the halt event must get to the client before the response.
+		cancel()
+		<-forwarded
+		s.send(rv)
+	}()
+}
+
+func (s *serverConn) call(m *remoteMessage) *remoteMessage {
+	rv := &remoteMessage{ID: m.ID}
+	var err error
+
+	a := s.adapter
+	switch m.Method {
+	case "Close":
+		err = a.Close()
+	case "Info":
+		rv.Text = a.Info()
+	case "ID":
+		rv.Text = a.ID()
+	case "Capabilities":
+		rv.Value = uint16(a.Capabilities())
+	case "SetMCU":
+		var mcu common.MCU
+		mcu, err = GetMCUBySignature(m.Value)
+		if err == nil {
+			a.SetMCU(mcu)
+		}
+	case "Enable":
+		err = a.Enable()
+	case "Disable":
+		err = a.Disable()
+	case "SwitchMode":
+		err = a.SwitchMode(m.BpSet)
+	case "Reset":
+		err = a.Reset()
+	case "ReadSignature":
+		rv.Value, err = a.ReadSignature()
+	case "ChipErase":
+		err = a.ChipErase()
+	case "SendBreak":
+		err = a.SendBreak()
+	case "RecvBreak":
+		err = a.RecvBreak()
+	case "Go":
+		err = a.Go()
+	case "ResetAndGo":
+		err = a.ResetAndGo()
+	case "Step":
+		err = a.Step()
+	case "Continue":
+		err = a.Continue(m.Value, m.BpSet, m.Timers)
+	case "WriteInstruction":
+		err = a.WriteInstruction(m.Value)
+	case "SetPC":
+		err = a.SetPC(m.Value)
+	case "GetPC":
+		rv.Value, err = a.GetPC()
+	case "WriteRegisters":
+		err = a.WriteRegisters(byte(m.Value), m.Data)
+	case "ReadRegisters":
+		rv.Data, err = s.readBuffer(m)
+		if err == nil {
+			err = a.ReadRegisters(byte(m.Value), rv.Data)
+		}
+	case "WriteSRAM":
+		err = a.WriteSRAM(m.Value, m.Data)
+	case "ReadSRAM":
+		rv.Data, err = s.readBuffer(m)
+		if err == nil {
+			err = a.ReadSRAM(m.Value, rv.Data)
+		}
+	case "ReadFlash":
+		rv.Data, err = s.readBuffer(m)
+		if err == nil {
+			err = a.ReadFlash(m.Value, rv.Data)
+		}
+	case "WriteFlashPage":
+		err = a.WriteFlashPage(m.Value, m.Data)
+	case "EraseFlashPage":
+		err = a.EraseFlashPage(m.Value)
+	case "ReadEEPROM":
+		rv.Data, err = s.readBuffer(m)
+		if err == nil {
+			err = a.ReadEEPROM(m.Value, rv.Data)
+		}
+	case "WriteEEPROM":
+		err = a.WriteEEPROM(m.Value, m.Data)
+	case "ReadFuses":
+		rv.Data, err = a.ReadFuses()
+	case "WriteLFuse":
+		err = a.WriteLFuse(byte(m.Value))
+	case "WriteHFuse":
+		err = a.WriteHFuse(byte(m.Value))
+	case "WriteEFuse":
+		err = a.WriteEFuse(byte(m.Value))
+	case "WriteLock":
+		err = a.WriteLock(byte(m.Value))
+	default:
+		err = fmt.Errorf("debugwire: remote: unknown method: %s", m.Method)
+	}
+
+	if err != nil {
+		rv.Error = err.Error()
+	}
+	return rv
+}
+
+// readBuffer allocates the buffer for a read request, after checking that the
+// requested range fits the memory being read.
+func (s *serverConn) readBuffer(m *remoteMessage) ([]byte, error) {
+	size := 0
+	switch m.Method {
+	case "ReadRegisters":
+		size = 32
+	case "ReadSRAM":
+		size = 0x10000
+		if mcu, ok := s.adapter.GetMCU().(interface {
+			SRAMStart() uint16
+			SRAMSize() uint16
+		}); ok {
+			size = int(mcu.SRAMStart()) + int(mcu.SRAMSize())
+		}
+	default:
+		mcu := s.adapter.GetMCU()
+		if mcu == nil {
+			return nil, fmt.Errorf("debugwire: remote: %s: target device not set", m.Method)
+		}
+		if m.Method == "ReadFlash" {
+			size = int(mcu.FlashSize())
+		} else {
+			size = int(mcu.EEPROMSize())
+		}
+	}
+
+	if m.Length < 0 || int(m.Value)+m.Length > size {
+		return nil, fmt.Errorf("debugwire: remote: %s: invalid range: 0x%04x+%d", m.Method, m.Value, m.Length)
+	}
+	return make([]byte, m.Length), nil
+}
@@ -0,0 +1,285 @@
+package sim
+
+import (
+	"github.com/dwtk/devices"
+	"github.com/dwtk/dwtk/avr"
+)
+
+const (
+	sregC = iota
+	sregZ
+	sregN
+	sregV
+	sregS
+	sregH
+	sregT
+	sregI
+)
+
+const (
+	eepm0 = byte(1 << 4)
+	eepm1 = byte(1 << 5)
+	sigrd = byte(1 << 5)
+)
+
+type core struct {
+	mcu    *devices.MCU
+	layout memoryLayout
+
+	flash  []byte
+	eeprom []byte
+	data   []byte
+	fuses  []byte
+	pc     uint16 // word address
+
+	spmBuffer []byte
+}
+
+func newCore(mcu *devices.MCU) *core {
+	layout := getMemoryLayout(mcu)
+	rv := &core{
+		mcu:       mcu,
+		layout:    layout,
+		flash:     make([]byte, mcu.FlashSize()),
+		eeprom:    make([]byte, mcu.EEPROMSize()),
+		data:      make([]byte, uint32(layout.ramEnd())+1),
+		fuses:     []byte{0x62, 0xff, 0xff, 0xff &^ mcu.DWENMask()},
+		spmBuffer: make([]byte, mcu.FlashPageSize()),
+	}
+	rv.chipErase()
+	rv.reset()
+	return rv
+}
+
+func (c *core) reset() {
+	for i := uint16(0); i < c.layout.sramStart; i++ {
+		c.data[i] = 0
+	}
+	c.setSP(c.layout.ramEnd())
+	c.pc = 0
+}
+
+func (c *core) chipErase() {
+	for i := range c.flash {
+		c.flash[i] = 0xff
+	}
+	for i := range c.eeprom {
+		c.eeprom[i] = 0xff
+	}
+	for i := range c.spmBuffer {
+		c.spmBuffer[i] = 0xff
+	}
+}
+
+func (c *core) flashWords() uint16 {
+	return uint16(len(c.flash) / 2)
+}
+
+func (c *core) flashWord(addr uint16) uint16 {
+	a := int(addr%c.flashWords()) * 2
+	return uint16(c.flash[a]) | (uint16(c.flash[a+1]) << 8)
+}
+
+func (c *core) flashByte(addr uint16) byte {
+	return c.flash[int(addr)%len(c.flash)]
+}
+
+func (c *core) reg(r byte) byte {
+	return c.data[r&0x1f]
+}
+
+func (c *core) setReg(r byte, v byte) {
+	c.data[r&0x1f] = v
+}
+
+func (c *core) regPair(r byte) uint16 {
+	return uint16(c.data[r]) | (uint16(c.data[r+1]) << 8)
+}
+
+func (c *core) setRegPair(r byte, v uint16) {
+	c.data[r] = byte(v)
+	c.data[r+1] = byte(v >> 8)
+}
+
+func (c *core) sreg() byte {
+	return c.data[c.mcu.SREG().Mem16()]
+}
+
+func (c *core) setSreg(v byte) {
+	c.data[c.mcu.SREG().Mem16()] = v
+}
+
+func (c *core) flag(bit uint) bool {
+	return c.sreg()&(1<<bit) != 0
+}
+
+func (c *core) setFlag(bit uint, v bool) {
+	if v {
+		c.setSreg(c.sreg() | (1 << bit))
+	} else {
+		c.setSreg(c.sreg() &^ (1 << bit))
+	}
+}
+
+func (c *core) sp() uint16 {
+	rv := uint16(c.data[c.mcu.SP().Mem16()])
+	if c.mcu.SP().Size() > 1 {
+		rv |= uint16(c.data[c.mcu.SP().Mem16()+1]) << 8
+	}
+	return rv
+}
+
+func (c *core) setSP(v uint16) {
+	c.data[c.mcu.SP().Mem16()] = byte(v)
+	if c.mcu.SP().Size() > 1 {
+		c.data[c.mcu.SP().Mem16()+1] = byte(v >> 8)
+	}
+}
+
+func (c *core) push(v byte) {
+	sp := c.sp()
+	c.write(sp, v)
+	c.setSP(sp - 1)
+}
+
+func (c *core) pop() byte {
+	sp := c.sp() + 1
+	c.setSP(sp)
+	return c.read(sp)
+}
+
+func (c *core) pushPC(pc uint16) {
+	c.push(byte(pc))
+	c.push(byte(pc >> 8))
+}
+
+func (c *core) popPC() uint16 {
+	h := c.pop()
+	return (uint16(h) << 8) | uint16(c.pop())
+}
+
+func (c *core) read(addr uint16) byte {
+	if int(addr) >= len(c.data) {
+		return 0
+	}
+	return c.data[addr]
+}
+
+func (c *core) write(addr uint16, v byte) {
+	if int(addr) >= len(c.data) {
+		return
+	}
+
+	if addr == c.mcu.EECR().Mem16() {
+		c.writeEECR(v)
+		return
+	}
+
+	c.data[addr] = v
+}
+
+func (c *core) eear() uint16 {
+	rv := uint16(c.data[c.mcu.EEAR().Mem16()])
+	if c.mcu.EEAR().Size() > 1 {
+		rv |= uint16(c.data[c.mcu.EEAR().Mem16()+1]) << 8
+	}
+	return rv
+}
+
+func (c *core) writeEECR(v byte) {
+	addr := c.mcu.EECR().Mem16()
+	old := c.data[addr]
+	ee := int(c.eear()) % len(c.eeprom)
+
+	if v&avr.EERE != 0 {
+		c.data[c.mcu.EEDR().Mem16()] = c.eeprom[ee]
+		v &^= avr.EERE
+	}
+
+	if v&avr.EEPE != 0 {
+		if old&avr.EEMPE != 0 {
+			d := c.data[c.mcu.EEDR().Mem16()]
+			switch v & (eepm0 | eepm1) {
+			case 0:
+				c.eeprom[ee] = d
+			case eepm0:
+				c.eeprom[ee] = 0xff
+			case eepm1:
+				c.eeprom[ee] &= d
+			}
+		}
+		v &^= avr.EEPE | avr.EEMPE
+	}
+
+	c.data[addr] = v
+}
+
+func (c *core) lpm(z uint16) byte {
+	spmcsr := c.mcu.SPMCSR().Mem16()
+	s := c.data[spmcsr]
+	c.data[spmcsr] = s &^ (avr.RFLB | sigrd | avr.SPMEN)
+
+	if s&avr.SPMEN == 0 {
+		return c.flashByte(z)
+	}
+
+	if s&avr.RFLB != 0 {
+		if int(z) < len(c.fuses) {
+			return c.fuses[z]
+		}
+		return 0xff
+	}
+
+	if s&sigrd != 0 {
+		switch z {
+		case 0:
+			return 0x1e
+		case 2:
+			return byte(c.mcu.Signature() >> 8)
+		case 4:
+			return byte(c.mcu.Signature())
+		}
+		return 0xff
+	}
+
+	return c.flashByte(z)
+}
+
+func (c *core) spm(z uint16) {
+	spmcsr := c.mcu.SPMCSR().Mem16()
+	s := c.data[spmcsr]
+	c.data[spmcsr] = s &^ (avr.SPMEN | avr.PGERS | avr.PGWRT | avr.RFLB | avr.RWWSRE | sigrd)
+
+	if s&avr.SPMEN == 0 {
+		return
+	}
+
+	ps := c.mcu.FlashPageSize()
+	page := int(z&^(ps-1)) % len(c.flash)
+
+	switch s &^ avr.SPMEN {
+	case 0:
+		o := z & (ps - 1) &^ 1
+		c.spmBuffer[o] = c.data[0]
+		c.spmBuffer[o+1] = c.data[1]
+
+	case avr.PGERS:
+		for i := 0; i < int(ps); i++ {
+			c.flash[page+i] = 0xff
+		}
+
+	case avr.PGWRT:
+		for i := 0; i < int(ps); i++ {
+			c.flash[page+i] &= c.spmBuffer[i]
+			c.spmBuffer[i] = 0xff
+		}
+
+	case avr.RWWSRE: // also CTPB
+		for i := range c.spmBuffer {
+			c.spmBuffer[i] = 0xff
+		}
+
+	case avr.RFLB:
+		c.fuses[avr.LOCKBIT] &= c.data[0]
+	}
+}
@@ -0,0 +1,35 @@
+package sim
+
+import (
+	"fmt"
+)
+
+// the debugwire package accesses the EEPROM with instructions, these are for
+// adapters built on top of the simulator.
+
+func (s *SimAdapter) checkEEPROM(start uint16, data []byte) error {
+	if int(start)+len(data) > len(s.core.eeprom) {
+		return fmt.Errorf("debugwire: sim: eeprom access out of bounds: 0x%04x + 0x%04x > 0x%04x", start, len(data), len(s.core.eeprom))
+	}
+	return nil
+}
+
+func (s *SimAdapter) ReadEEPROM(start uint16, data []byte) error {
+	if err := s.checkEEPROM(start, data); err != nil {
+		return err
+	}
+	return s.locked(func() error {
+		copy(data, s.core.eeprom[start:])
+		return nil
+	})
+}
+
+func (s *SimAdapter) WriteEEPROM(start uint16, data []byte) error {
+	if err := s.checkEEPROM(start, data); err != nil {
+		return err
+	}
+	return s.locked(func() error {
+		copy(s.core.eeprom[start:], data)
+		return nil
+	})
+}
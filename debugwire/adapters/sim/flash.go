@@ -0,0 +1,44 @@
+package sim
+
+import (
+	"fmt"
+)
+
+func (s *SimAdapter) checkFlash(start uint16, size int) error {
+	if int(start)+size > len(s.core.flash) {
+		return fmt.Errorf("debugwire: sim: flash access out of bounds: 0x%04x + 0x%04x > 0x%04x", start, size, len(s.core.flash))
+	}
+	return nil
+}
+
+func (s *SimAdapter) ReadFlash(start uint16, data []byte) error {
+	if err := s.checkFlash(start, len(data)); err != nil {
+		return err
+	}
+	return s.locked(func() error {
+		copy(data, s.core.flash[start:])
+		return nil
+	})
+}
+
+func (s *SimAdapter) WriteFlashPage(start uint16, data []byte) error {
+	if err := s.checkFlash(start, len(data)); err != nil {
+		return err
+	}
+	return s.locked(func() error {
+		copy(s.core.flash[start:], data)
+		return nil
+	})
+}
+
+func (s *SimAdapter) EraseFlashPage(start uint16) error {
+	if err := s.checkFlash(start, int(s.core.mcu.FlashPageSize())); err != nil {
+		return err
+	}
+	return s.locked(func() error {
+		for i := uint16(0); i < s.core.mcu.FlashPageSize(); i++ {
+			s.core.flash[start+i] = 0xff
+		}
+		return nil
+	})
+}
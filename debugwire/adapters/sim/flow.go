@@ -0,0 +1,137 @@
+package sim
+
+import (
+	"context"
+	"runtime"
+)
+
+// instructions executed between checks for break requests.
+const batchSize = 1024
+
+func (s *SimAdapter) SendBreak() error {
+	s.halt()
+	return nil
+}
+
+func (s *SimAdapter) RecvBreak() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.running {
+		return errRunning
+	}
+	return nil
+}
+
+func (s *SimAdapter) Go() error {
+	return s.run(0, false)
+}
+
+func (s *SimAdapter) ResetAndGo() error {
+	if err := s.Reset(); err != nil {
+		return err
+	}
+	return s.Go()
+}
+
+func (s *SimAdapter) Step() error {
+	return s.locked(func() error {
+		s.core.step()
+		return nil
+	})
+}
+
+func (s *SimAdapter) Continue(hwBreakpoint uint16, hwBreakpointSet bool, timers bool) error {
+	// we don't simulate peripherals, so timers are ignored.
+	return s.run(hwBreakpoint/2, hwBreakpointSet)
+}
+
+func (s *SimAdapter) Wait(ctx context.Context, c chan bool) error {
+	s.mutex.Lock()
+	done := s.done
+	s.mutex.Unlock()
+
+	if done == nil {
+		return errNotRunning
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-done:
+	}
+
+	select {
+	case <-ctx.Done():
+	case c <- true:
+	}
+	return nil
+}
+
+func (s *SimAdapter) WriteInstruction(inst uint16) error {
+	return s.locked(func() error {
+		pc := s.core.pc
+		s.core.exec(inst, 0)
+		s.core.pc = pc
+		return nil
+	})
+}
+
+func (s *SimAdapter) run(bp uint16, bpSet bool) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.running {
+		return errRunning
+	}
+
+	s.running = true
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+
+	go func(stop chan struct{}, done chan struct{}) {
+		defer close(done)
+
+		first := true
+		for {
+			select {
+			case <-stop:
+				s.mutex.Lock()
+				s.running = false
+				s.mutex.Unlock()
+				return
+			default:
+			}
+
+			s.mutex.Lock()
+			if s.core.run(batchSize, bp, bpSet, first) {
+				s.running = false
+				s.mutex.Unlock()
+				return
+			}
+			first = false
+			s.mutex.Unlock()
+
+			runtime.Gosched()
+		}
+	}(s.stop, s.done)
+
+	return nil
+}
+
+func (s *SimAdapter) halt() {
+	s.mutex.Lock()
+	if !s.running {
+		s.mutex.Unlock()
+		return
+	}
+	select {
+	case <-s.stop:
+	default:
+		close(s.stop)
+	}
+	done := s.done
+	s.mutex.Unlock()
+
+	<-done
+}
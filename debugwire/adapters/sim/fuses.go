@@ -0,0 +1,37 @@
+package sim
+
+import (
+	"github.com/dwtk/dwtk/avr"
+)
+
+func (s *SimAdapter) ReadFuses() ([]byte, error) {
+	rv := make([]byte, 4)
+	err := s.locked(func() error {
+		copy(rv, s.core.fuses)
+		return nil
+	})
+	return rv, err
+}
+
+func (s *SimAdapter) writeFuse(idx int, data byte) error {
+	return s.locked(func() error {
+		s.core.fuses[idx] = data
+		return nil
+	})
+}
+
+func (s *SimAdapter) WriteLFuse(data byte) error {
+	return s.writeFuse(avr.LOW_FUSE, data)
+}
+
+func (s *SimAdapter) WriteHFuse(data byte) error {
+	return s.writeFuse(avr.HIGH_FUSE, data)
+}
+
+func (s *SimAdapter) WriteEFuse(data byte) error {
+	return s.writeFuse(avr.EXTENDED_FUSE, data)
+}
+
+func (s *SimAdapter) WriteLock(data byte) error {
+	return s.writeFuse(avr.LOCKBIT, data)
+}
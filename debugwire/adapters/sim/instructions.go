@@ -0,0 +1,432 @@
+package sim
+
+import (
+	"github.com/dwtk/dwtk/avr"
+)
+
+func bit(v uint16, n uint) bool {
+	return v&(1<<n) != 0
+}
+
+func (c *core) setNZS(r byte, v bool) {
+	n := r&0x80 != 0
+	c.setFlag(sregN, n)
+	c.setFlag(sregZ, r == 0)
+	c.setFlag(sregV, v)
+	c.setFlag(sregS, n != v)
+}
+
+func (c *core) add(d byte, r byte, carry bool) byte {
+	cin := byte(0)
+	if carry && c.flag(sregC) {
+		cin = 1
+	}
+	rv := d + r + cin
+	rd, rr, res := uint16(d), uint16(r), uint16(rv)
+	c.setFlag(sregH, (bit(rd, 3) && bit(rr, 3)) || (bit(rr, 3) && !bit(res, 3)) || (!bit(res, 3) && bit(rd, 3)))
+	c.setFlag(sregC, (bit(rd, 7) && bit(rr, 7)) || (bit(rr, 7) && !bit(res, 7)) || (!bit(res, 7) && bit(rd, 7)))
+	c.setNZS(rv, (bit(rd, 7) && bit(rr, 7) && !bit(res, 7)) || (!bit(rd, 7) && !bit(rr, 7) && bit(res, 7)))
+	return rv
+}
+
+func (c *core) sub(d byte, r byte, carry bool) byte {
+	cin := byte(0)
+	if carry && c.flag(sregC) {
+		cin = 1
+	}
+	z := c.flag(sregZ)
+	rv := d - r - cin
+	rd, rr, res := uint16(d), uint16(r), uint16(rv)
+	c.setFlag(sregH, (!bit(rd, 3) && bit(rr, 3)) || (bit(rr, 3) && bit(res, 3)) || (bit(res, 3) && !bit(rd, 3)))
+	c.setFlag(sregC, (!bit(rd, 7) && bit(rr, 7)) || (bit(rr, 7) && bit(res, 7)) || (bit(res, 7) && !bit(rd, 7)))
+	c.setNZS(rv, (bit(rd, 7) && !bit(rr, 7) && !bit(res, 7)) || (!bit(rd, 7) && bit(rr, 7) && bit(res, 7)))
+	if carry {
+		c.setFlag(sregZ, z && rv == 0)
+	}
+	return rv
+}
+
+func (c *core) logic(rv byte) byte {
+	c.setNZS(rv, false)
+	return rv
+}
+
+func (c *core) mul(d int32, r int32, shift bool) {
+	rv := uint32(d*r) & 0xffff
+	c.setFlag(sregC, rv&0x8000 != 0)
+	if shift {
+		rv = (rv << 1) & 0xffff
+	}
+	c.setFlag(sregZ, rv == 0)
+	c.setRegPair(0, uint16(rv))
+}
+
+// skip returns the address of the instruction after the one following pc.
+func (c *core) skip(pc uint16) uint16 {
+	if avr.IsTwoWord(c.flashWord(pc + 1)) {
+		return pc + 3
+	}
+	return pc + 2
+}
+
+// exec executes a single instruction located at c.pc. next is the word
+// following the instruction, used by two-word instructions. exec returns
+// true if the instruction is a BREAK, without changing the program counter.
+func (c *core) exec(op uint16, next uint16) bool {
+	pc := c.pc + 1
+
+	d := byte((op >> 4) & 0x1f)
+	r := byte((op & 0x0f) | ((op >> 5) & 0x10))
+	k8 := byte((op & 0x0f) | ((op >> 4) & 0xf0))
+	dh := d | 0x10
+	io := uint16((op & 0x0f) | ((op >> 5) & 0x30))
+	b := uint(op & 0x07)
+
+	switch {
+	case op == 0x0000: // NOP
+
+	case op&0xff00 == 0x0100: // MOVW
+		c.setRegPair((d&0x0f)<<1, c.regPair((byte(op)&0x0f)<<1))
+
+	case op&0xff00 == 0x0200: // MULS
+		c.mul(int32(int8(c.reg(dh))), int32(int8(c.reg(byte(op&0x0f)|0x10))), false)
+
+	case op&0xff88 == 0x0300: // MULSU
+		c.mul(int32(int8(c.reg(byte((op>>4)&0x07)|0x10))), int32(c.reg(byte(op&0x07)|0x10)), false)
+
+	case op&0xff88 == 0x0308: // FMUL
+		c.mul(int32(c.reg(byte((op>>4)&0x07)|0x10)), int32(c.reg(byte(op&0x07)|0x10)), true)
+
+	case op&0xff88 == 0x0380: // FMULS
+		c.mul(int32(int8(c.reg(byte((op>>4)&0x07)|0x10))), int32(int8(c.reg(byte(op&0x07)|0x10))), true)
+
+	case op&0xff88 == 0x0388: // FMULSU
+		c.mul(int32(int8(c.reg(byte((op>>4)&0x07)|0x10))), int32(c.reg(byte(op&0x07)|0x10)), true)
+
+	case op&0xfc00 == 0x0400: // CPC
+		c.sub(c.reg(d), c.reg(r), true)
+
+	case op&0xfc00 == 0x0800: // SBC
+		c.setReg(d, c.sub(c.reg(d), c.reg(r), true))
+
+	case op&0xfc00 == 0x0c00: // ADD
+		c.setReg(d, c.add(c.reg(d), c.reg(r), false))
+
+	case op&0xfc00 == 0x1000: // CPSE
+		if c.reg(d) == c.reg(r) {
+			pc = c.skip(c.pc)
+		}
+
+	case op&0xfc00 == 0x1400: // CP
+		c.sub(c.reg(d), c.reg(r), false)
+
+	case op&0xfc00 == 0x1800: // SUB
+		c.setReg(d, c.sub(c.reg(d), c.reg(r), false))
+
+	case op&0xfc00 == 0x1c00: // ADC
+		c.setReg(d, c.add(c.reg(d), c.reg(r), true))
+
+	case op&0xfc00 == 0x2000: // AND
+		c.setReg(d, c.logic(c.reg(d)&c.reg(r)))
+
+	case op&0xfc00 == 0x2400: // EOR
+		c.setReg(d, c.logic(c.reg(d)^c.reg(r)))
+
+	case op&0xfc00 == 0x2800: // OR
+		c.setReg(d, c.logic(c.reg(d)|c.reg(r)))
+
+	case op&0xfc00 == 0x2c00: // MOV
+		c.setReg(d, c.reg(r))
+
+	case op&0xf000 == 0x3000: // CPI
+		c.sub(c.reg(dh), k8, false)
+
+	case op&0xf000 == 0x4000: // SBCI
+		c.setReg(dh, c.sub(c.reg(dh), k8, true))
+
+	case op&0xf000 == 0x5000: // SUBI
+		c.setReg(dh, c.sub(c.reg(dh), k8, false))
+
+	case op&0xf000 == 0x6000: // ORI
+		c.setReg(dh, c.logic(c.reg(dh)|k8))
+
+	case op&0xf000 == 0x7000: // ANDI
+		c.setReg(dh, c.logic(c.reg(dh)&k8))
+
+	case op&0xd000 == 0x8000: // LDD/STD Y+q, Z+q
+		q := (op & 0x07) | ((op >> 7) & 0x18) | ((op >> 8) & 0x20)
+		base := byte(30)
+		if op&0x0008 != 0 {
+			base = 28
+		}
+		addr := c.regPair(base) + q
+		if op&0x0200 != 0 {
+			c.write(addr, c.reg(d))
+		} else {
+			c.setReg(d, c.read(addr))
+		}
+
+	case op&0xfe00 == 0x9000: // loads
+		switch op & 0x000f {
+		case 0x0: // LDS
+			c.setReg(d, c.read(next))
+			pc++
+		case 0x1: // LD Z+
+			z := c.regPair(30)
+			c.setReg(d, c.read(z))
+			c.setRegPair(30, z+1)
+		case 0x2: // LD -Z
+			z := c.regPair(30) - 1
+			c.setRegPair(30, z)
+			c.setReg(d, c.read(z))
+		case 0x4, 0x6: // LPM Z, ELPM Z
+			c.setReg(d, c.lpm(c.regPair(30)))
+		case 0x5, 0x7: // LPM Z+, ELPM Z+
+			z := c.regPair(30)
+			c.setReg(d, c.lpm(z))
+			c.setRegPair(30, z+1)
+		case 0x9: // LD Y+
+			y := c.regPair(28)
+			c.setReg(d, c.read(y))
+			c.setRegPair(28, y+1)
+		case 0xa: // LD -Y
+			y := c.regPair(28) - 1
+			c.setRegPair(28, y)
+			c.setReg(d, c.read(y))
+		case 0xc: // LD X
+			c.setReg(d, c.read(c.regPair(26)))
+		case 0xd: // LD X+
+			x := c.regPair(26)
+			c.setReg(d, c.read(x))
+			c.setRegPair(26, x+1)
+		case 0xe: // LD -X
+			x := c.regPair(26) - 1
+			c.setRegPair(26, x)
+			c.setReg(d, c.read(x))
+		case 0xf: // POP
+			c.setReg(d, c.pop())
+		}
+
+	case op&0xfe00 == 0x9200: // stores
+		switch op & 0x000f {
+		case 0x0: // STS
+			c.write(next, c.reg(d))
+			pc++
+		case 0x1: // ST Z+
+			z := c.regPair(30)
+			c.write(z, c.reg(d))
+			c.setRegPair(30, z+1)
+		case 0x2: // ST -Z
+			z := c.regPair(30) - 1
+			c.setRegPair(30, z)
+			c.write(z, c.reg(d))
+		case 0x9: // ST Y+
+			y := c.regPair(28)
+			c.write(y, c.reg(d))
+			c.setRegPair(28, y+1)
+		case 0xa: // ST -Y
+			y := c.regPair(28) - 1
+			c.setRegPair(28, y)
+			c.write(y, c.reg(d))
+		case 0xc: // ST X
+			c.write(c.regPair(26), c.reg(d))
+		case 0xd: // ST X+
+			x := c.regPair(26)
+			c.write(x, c.reg(d))
+			c.setRegPair(26, x+1)
+		case 0xe: // ST -X
+			x := c.regPair(26) - 1
+			c.setRegPair(26, x)
+			c.write(x, c.reg(d))
+		case 0xf: // PUSH
+			c.push(c.reg(d))
+		}
+
+	case op&0xff8f == 0x9408: // BSET
+		c.setFlag(uint((op>>4)&0x07), true)
+
+	case op&0xff8f == 0x9488: // BCLR
+		c.setFlag(uint((op>>4)&0x07), false)
+
+	case op == 0x9508: // RET
+		pc = c.popPC()
+
+	case op == 0x9518: // RETI
+		pc = c.popPC()
+		c.setFlag(sregI, true)
+
+	case op == 0x9588: // SLEEP
+
+	case op == 0x9598: // BREAK
+		return true
+
+	case op == 0x95a8: // WDR
+
+	case op == 0x95c8, op == 0x95d8: // LPM, ELPM
+		c.setReg(0, c.lpm(c.regPair(30)))
+
+	case op == 0x95e8: // SPM
+		c.spm(c.regPair(30))
+
+	case op == 0x95f8: // SPM Z+
+		z := c.regPair(30)
+		c.spm(z)
+		c.setRegPair(30, z+2)
+
+	case op == 0x9409, op == 0x9419: // IJMP, EIJMP
+		pc = c.regPair(30)
+
+	case op == 0x9509, op == 0x9519: // ICALL, EICALL
+		c.pushPC(pc)
+		pc = c.regPair(30)
+
+	case op&0xfe0e == 0x940c: // JMP
+		pc = next
+
+	case op&0xfe0e == 0x940e: // CALL
+		c.pushPC(pc + 1)
+		pc = next
+
+	case op&0xfe0f == 0x9400: // COM
+		rv := ^c.reg(d)
+		c.setReg(d, rv)
+		c.setNZS(rv, false)
+		c.setFlag(sregC, true)
+
+	case op&0xfe0f == 0x9401: // NEG
+		rd := c.reg(d)
+		rv := -rd
+		c.setReg(d, rv)
+		c.setFlag(sregH, bit(uint16(rv), 3) || bit(uint16(rd), 3))
+		c.setFlag(sregC, rv != 0)
+		c.setNZS(rv, rv == 0x80)
+
+	case op&0xfe0f == 0x9402: // SWAP
+		rd := c.reg(d)
+		c.setReg(d, (rd<<4)|(rd>>4))
+
+	case op&0xfe0f == 0x9403: // INC
+		rv := c.reg(d) + 1
+		c.setReg(d, rv)
+		c.setNZS(rv, rv == 0x80)
+
+	case op&0xfe0f == 0x940a: // DEC
+		rv := c.reg(d) - 1
+		c.setReg(d, rv)
+		c.setNZS(rv, rv == 0x7f)
+
+	case op&0xfe0f == 0x9405, op&0xfe0f == 0x9406, op&0xfe0f == 0x9407: // ASR, LSR, ROR
+		rd := c.reg(d)
+		rv := rd >> 1
+		switch op & 0x000f {
+		case 0x5:
+			rv |= rd & 0x80
+		case 0x7:
+			if c.flag(sregC) {
+				rv |= 0x80
+			}
+		}
+		carry := rd&0x01 != 0
+		c.setReg(d, rv)
+		c.setFlag(sregC, carry)
+		c.setNZS(rv, (rv&0x80 != 0) != carry)
+
+	case op&0xff00 == 0x9600, op&0xff00 == 0x9700: // ADIW, SBIW
+		rd := 24 + byte((op>>3)&0x06)
+		k := (op & 0x0f) | ((op >> 2) & 0x30)
+		v := c.regPair(rd)
+		var rv uint16
+		if op&0x0100 == 0 {
+			rv = v + k
+			c.setFlag(sregC, !bit(rv, 15) && bit(v, 15))
+			c.setFlag(sregV, !bit(v, 15) && bit(rv, 15))
+		} else {
+			rv = v - k
+			c.setFlag(sregC, bit(rv, 15) && !bit(v, 15))
+			c.setFlag(sregV, bit(v, 15) && !bit(rv, 15))
+		}
+		c.setRegPair(rd, rv)
+		c.setFlag(sregN, bit(rv, 15))
+		c.setFlag(sregZ, rv == 0)
+		c.setFlag(sregS, c.flag(sregN) != c.flag(sregV))
+
+	case op&0xfd00 == 0x9800: // CBI, SBI
+		addr := 0x20 + ((op >> 3) & 0x1f)
+		v := c.read(addr)
+		if op&0x0200 != 0 {
+			v |= 1 << b
+		} else {
+			v &^= 1 << b
+		}
+		c.write(addr, v)
+
+	case op&0xfd00 == 0x9900: // SBIC, SBIS
+		v := c.read(0x20+((op>>3)&0x1f))&(1<<b) != 0
+		if v == (op&0x0200 != 0) {
+			pc = c.skip(c.pc)
+		}
+
+	case op&0xfc00 == 0x9c00: // MUL
+		c.mul(int32(c.reg(d)), int32(c.reg(r)), false)
+
+	case op&0xf800 == 0xb000: // IN
+		c.setReg(d, c.read(0x20+io))
+
+	case op&0xf800 == 0xb800: // OUT
+		c.write(0x20+io, c.reg(d))
+
+	case op&0xf000 == 0xc000: // RJMP
+		pc = c.pc + 1 + uint16(int16(op<<4)>>4)
+
+	case op&0xf000 == 0xd000: // RCALL
+		c.pushPC(pc)
+		pc = c.pc + 1 + uint16(int16(op<<4)>>4)
+
+	case op&0xf000 == 0xe000: // LDI
+		c.setReg(dh, k8)
+
+	case op&0xf800 == 0xf000: // BRBS, BRBC
+		if c.flag(b) == (op&0x0400 == 0) {
+			pc = c.pc + 1 + uint16(int16(op<<6)>>9)
+		}
+
+	case op&0xfe08 == 0xf800: // BLD
+		if c.flag(sregT) {
+			c.setReg(d, c.reg(d)|(1<<b))
+		} else {
+			c.setReg(d, c.reg(d)&^(1<<b))
+		}
+
+	case op&0xfe08 == 0xfa00: // BST
+		c.setFlag(sregT, c.reg(d)&(1<<b) != 0)
+
+	case op&0xfc08 == 0xfc00: // SBRC, SBRS
+		if (c.reg(d)&(1<<b) != 0) == (op&0x0200 != 0) {
+			pc = c.skip(c.pc)
+		}
+	}
+
+	c.pc = pc % c.flashWords()
+	return false
+}
+
+// step executes the instruction at the current program counter.
+func (c *core) step() bool {
+	return c.exec(c.flashWord(c.pc), c.flashWord(c.pc+1))
+}
+
+// run executes up to n instructions, and returns true if the core halted
+// because of a BREAK or because it reached the hardware breakpoint. The
+// breakpoint is not checked for the first instruction when skipFirst is set,
+// so that we can resume from it.
+func (c *core) run(n int, bp uint16, bpSet bool, skipFirst bool) bool {
+	for i := 0; i < n; i++ {
+		if bpSet && c.pc == bp && !(skipFirst && i == 0) {
+			return true
+		}
+		if c.step() {
+			return true
+		}
+	}
+	return false
+}
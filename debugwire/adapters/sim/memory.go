@@ -0,0 +1,107 @@
+package sim
+
+import (
+	"strings"
+
+	"github.com/dwtk/devices"
+)
+
+type memoryLayout struct {
+	sramStart uint16
+	sramSize  uint16
+}
+
+// devices.MCU does not provide SRAM information, so we keep it here.
+var (
+	layouts = map[string]memoryLayout{
+		"at90pwm1":        {0x0100, 0x0200},
+		"at90pwm161":      {0x0100, 0x0400},
+		"at90pwm216":      {0x0100, 0x0400},
+		"at90pwm2b":       {0x0100, 0x0200},
+		"at90pwm316":      {0x0100, 0x0400},
+		"at90pwm3b":       {0x0100, 0x0200},
+		"at90pwm81":       {0x0100, 0x0100},
+		"at90usb162":      {0x0100, 0x0200},
+		"at90usb82":       {0x0100, 0x0200},
+		"atmega168":       {0x0100, 0x0400},
+		"atmega168a":      {0x0100, 0x0400},
+		"atmega168p":      {0x0100, 0x0400},
+		"atmega168pa":     {0x0100, 0x0400},
+		"atmega168pb":     {0x0100, 0x0400},
+		"atmega16hva":     {0x0100, 0x0200},
+		"atmega16hvb":     {0x0100, 0x0400},
+		"atmega16hvbrevb": {0x0100, 0x0400},
+		"atmega16m1":      {0x0100, 0x0400},
+		"atmega16u2":      {0x0100, 0x0200},
+		"atmega328":       {0x0100, 0x0800},
+		"atmega328p":      {0x0100, 0x0800},
+		"atmega328pb":     {0x0100, 0x0800},
+		"atmega32c1":      {0x0100, 0x0800},
+		"atmega32hvb":     {0x0100, 0x0800},
+		"atmega32hvbrevb": {0x0100, 0x0800},
+		"atmega32m1":      {0x0100, 0x0800},
+		"atmega32u2":      {0x0100, 0x0400},
+		"atmega48":        {0x0100, 0x0200},
+		"atmega48a":       {0x0100, 0x0200},
+		"atmega48p":       {0x0100, 0x0200},
+		"atmega48pa":      {0x0100, 0x0200},
+		"atmega48pb":      {0x0100, 0x0200},
+		"atmega88":        {0x0100, 0x0400},
+		"atmega88a":       {0x0100, 0x0400},
+		"atmega88p":       {0x0100, 0x0400},
+		"atmega88pa":      {0x0100, 0x0400},
+		"atmega88pb":      {0x0100, 0x0400},
+		"atmega8hva":      {0x0100, 0x0200},
+		"atmega8u2":       {0x0100, 0x0200},
+		"attiny13":        {0x0060, 0x0040},
+		"attiny13a":       {0x0060, 0x0040},
+		"attiny1634":      {0x0100, 0x0400},
+		"attiny167":       {0x0100, 0x0200},
+		"attiny2313":      {0x0060, 0x0080},
+		"attiny2313a":     {0x0060, 0x0080},
+		"attiny24":        {0x0060, 0x0080},
+		"attiny24a":       {0x0060, 0x0080},
+		"attiny25":        {0x0060, 0x0080},
+		"attiny261":       {0x0060, 0x0080},
+		"attiny261a":      {0x0060, 0x0080},
+		"attiny4313":      {0x0060, 0x0100},
+		"attiny43u":       {0x0060, 0x0100},
+		"attiny44":        {0x0060, 0x0100},
+		"attiny441":       {0x0100, 0x0100},
+		"attiny44a":       {0x0060, 0x0100},
+		"attiny45":        {0x0060, 0x0100},
+		"attiny461":       {0x0060, 0x0100},
+		"attiny461a":      {0x0060, 0x0100},
+		"attiny48":        {0x0100, 0x0100},
+		"attiny828":       {0x0100, 0x0200},
+		"attiny84":        {0x0060, 0x0200},
+		"attiny841":       {0x0100, 0x0200},
+		"attiny84a":       {0x0060, 0x0200},
+		"attiny85":        {0x0060, 0x0200},
+		"attiny861":       {0x0060, 0x0200},
+		"attiny861a":      {0x0060, 0x0200},
+		"attiny87":        {0x0100, 0x0200},
+		"attiny88":        {0x0100, 0x0200},
+	}
+)
+
+func getMemoryLayout(mcu *devices.MCU) memoryLayout {
+	if l, ok := layouts[strings.ToLower(mcu.Name())]; ok {
+		return l
+	}
+
+	// unknown device, guess something that is big enough for most parts
+	// with the same flash size.
+	l := memoryLayout{
+		sramStart: 0x0060,
+		sramSize:  mcu.FlashSize() / 16,
+	}
+	if mcu.EEAR().Mem8() >= 0x40 {
+		l.sramStart = 0x0100
+	}
+	return l
+}
+
+func (l memoryLayout) ramEnd() uint16 {
+	return l.sramStart + l.sramSize - 1
+}
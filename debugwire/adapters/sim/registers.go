@@ -0,0 +1,48 @@
+package sim
+
+import (
+	"fmt"
+)
+
+func checkRegisters(start byte, regs []byte) error {
+	if int(start)+len(regs) > 32 {
+		return fmt.Errorf("debugwire: sim: invalid registers: %d + %d", start, len(regs))
+	}
+	return nil
+}
+
+func (s *SimAdapter) SetPC(pc uint16) error {
+	return s.locked(func() error {
+		s.core.pc = (pc / 2) % s.core.flashWords()
+		return nil
+	})
+}
+
+func (s *SimAdapter) GetPC() (uint16, error) {
+	rv := uint16(0)
+	err := s.locked(func() error {
+		rv = s.core.pc * 2
+		return nil
+	})
+	return rv, err
+}
+
+func (s *SimAdapter) WriteRegisters(start byte, regs []byte) error {
+	if err := checkRegisters(start, regs); err != nil {
+		return err
+	}
+	return s.locked(func() error {
+		copy(s.core.data[start:32], regs)
+		return nil
+	})
+}
+
+func (s *SimAdapter) ReadRegisters(start byte, regs []byte) error {
+	if err := checkRegisters(start, regs); err != nil {
+		return err
+	}
+	return s.locked(func() error {
+		copy(regs, s.core.data[start:32])
+		return nil
+	})
+}
@@ -0,0 +1,153 @@
+package sim
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/dwtk/devices"
+	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+	"github.com/dwtk/dwtk/firmware"
+	"github.com/dwtk/dwtk/internal/logger"
+)
+
+var (
+	errRunning    = errors.New("debugwire: sim: target is running, send a break first")
+	errNotRunning = errors.New("debugwire: sim: target was never resumed, nothing to wait for")
+)
+
+type SimAdapter struct {
+	core     *core
+	mcu      common.MCU
+	firmware string
+
+	mutex   *sync.Mutex
+	running bool
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// New creates a simulated target from a specification string, that is the
+// MCU name optionally followed by a firmware file to preload into flash,
+// e.g. "atmega328p" or "atmega328p firmware.elf".
+func New(spec string) (*SimAdapter, error) {
+	c, fw, err := newCoreFromSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug.Printf(" * Simulating %s", c.mcu.Name())
+
+	return &SimAdapter{
+		core:     c,
+		firmware: fw,
+		mutex:    &sync.Mutex{},
+	}, nil
+}
+
+func newCoreFromSpec(spec string) (*core, string, error) {
+	name, file := common.SplitSimSpec(spec)
+
+	mcu, err := devices.GetByName(name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	c := newCore(mcu)
+	if file == "" {
+		return c, "", nil
+	}
+
+	fw, err := firmware.NewFromFile(file, mcu)
+	if err != nil {
+		return nil, "", err
+	}
+	copy(c.flash, fw.Data)
+	return c, file, nil
+}
+
+func (s *SimAdapter) Close() error {
+	s.halt()
+	return nil
+}
+
+func (s *SimAdapter) Capabilities() common.Capabilities {
+	// peripherals aren't simulated, so there are no timers to control.
+	return common.CapDebugWIRE | common.CapFuseWrite | common.CapChipErase | common.CapNativeFuseRead | common.CapMemory
+}
+
+func (s *SimAdapter) ID() string {
+	return "sim " + s.core.mcu.Name()
+}
+
+func (s *SimAdapter) Info() string {
+	info := fmt.Sprintf("Simulator: %s\n", s.core.mcu.Name())
+	if s.firmware != "" {
+		info += fmt.Sprintf("Firmware:  %s\n", s.firmware)
+	}
+	info += fmt.Sprintf(`
+SRAM:   0x%04x bytes (0x%04x - 0x%04x)
+EEPROM: 0x%04x bytes
+`,
+		s.core.layout.sramSize,
+		s.core.layout.sramStart,
+		s.core.layout.ramEnd(),
+		len(s.core.eeprom))
+	return info
+}
+
+func (s *SimAdapter) SetMCU(mcu common.MCU) {
+	s.mcu = mcu
+}
+
+func (s *SimAdapter) GetMCU() common.MCU {
+	return s.mcu
+}
+
+func (s *SimAdapter) Enable() error {
+	return errors.New("debugwire: sim: target device is already running on debugWIRE mode")
+}
+
+func (s *SimAdapter) Disable() error {
+	return s.locked(func() error {
+		s.core.fuses[avr.HIGH_FUSE] |= s.core.mcu.DWENMask()
+		fmt.Println("debugWIRE was disabled for simulated target device.")
+		return nil
+	})
+}
+
+func (s *SimAdapter) SwitchMode(debugWIRE bool) error {
+	return errors.New("debugwire: sim: mode switch not supported")
+}
+
+func (s *SimAdapter) Reset() error {
+	s.halt()
+	return s.locked(func() error {
+		s.core.reset()
+		return nil
+	})
+}
+
+func (s *SimAdapter) ReadSignature() (uint16, error) {
+	return s.core.mcu.Signature(), nil
+}
+
+func (s *SimAdapter) ChipErase() error {
+	return s.locked(func() error {
+		s.core.chipErase()
+		s.core.fuses[avr.LOCKBIT] = 0xff
+		return nil
+	})
+}
+
+// locked runs f with the simulator halted and the core locked.
+func (s *SimAdapter) locked(f func() error) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.running {
+		return errRunning
+	}
+	return f()
+}
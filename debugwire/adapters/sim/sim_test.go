@@ -0,0 +1,136 @@
+package sim
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dwtk/dwtk/internal/adaptertest"
+)
+
+func TestConformance(t *testing.T) {
+	adaptertest.Run(t, func(t *testing.T) (adaptertest.Adapter, func()) {
+		s, err := New("atmega328p")
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.SetMCU(s.core.mcu)
+		return s, func() {
+			s.Close()
+		}
+	})
+}
+
+func newTestAdapter(t *testing.T, src string) *SimAdapter {
+	t.Helper()
+
+	s, err := New("atmega328p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.SetMCU(s.core.mcu)
+	adaptertest.WriteProgram(t, s, src)
+	return s
+}
+
+func TestStep(t *testing.T) {
+	s := newTestAdapter(t, `
+	loop:
+		ldi r16, 0x2a
+		inc r16
+		rjmp loop
+	`)
+
+	for _, exp := range []uint16{2, 4, 0} {
+		if err := s.Step(); err != nil {
+			t.Fatal(err)
+		}
+		adaptertest.CheckPC(t, s, exp)
+	}
+
+	r := make([]byte, 1)
+	if err := s.ReadRegisters(16, r); err != nil {
+		t.Fatal(err)
+	}
+	if r[0] != 0x2b {
+		t.Fatalf("bad r16: 0x%02x", r[0])
+	}
+}
+
+func TestHardwareBreakpoint(t *testing.T) {
+	s := newTestAdapter(t, `
+	loop:
+		nop
+		nop
+		nop
+		rjmp loop
+	`)
+
+	if err := s.Continue(4, true, false); err != nil {
+		t.Fatal(err)
+	}
+	adaptertest.WaitHalt(t, s)
+	adaptertest.CheckPC(t, s, 4)
+
+	// resuming from the breakpoint doesn't hit it again immediately.
+	if err := s.Continue(4, true, false); err != nil {
+		t.Fatal(err)
+	}
+	adaptertest.WaitHalt(t, s)
+	adaptertest.CheckPC(t, s, 4)
+}
+
+func TestSendBreak(t *testing.T) {
+	s := newTestAdapter(t, `
+	loop:
+		rjmp loop
+	`)
+
+	if err := s.Go(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Step(); err != errRunning {
+		t.Fatalf("step while running: %v", err)
+	}
+	if err := s.SendBreak(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.RecvBreak(); err != nil {
+		t.Fatal(err)
+	}
+	adaptertest.CheckPC(t, s, 0)
+}
+
+func TestWaitNotRunning(t *testing.T) {
+	s, err := New("atmega328p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Wait(context.Background(), make(chan bool, 1)); err != errNotRunning {
+		t.Fatalf("wait without run: %v", err)
+	}
+}
+
+func TestBounds(t *testing.T) {
+	s, err := New("atmega328p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.ReadSRAM(s.core.layout.ramEnd(), make([]byte, 2)); err == nil {
+		t.Fatal("out of bounds sram read succeeded")
+	}
+	if err := s.WriteRegisters(30, make([]byte, 3)); err == nil {
+		t.Fatal("out of bounds registers write succeeded")
+	}
+
+	size := s.core.mcu.FlashPageSize()
+	end := s.core.mcu.FlashSize() - size/2
+	if err := s.WriteFlashPage(end, make([]byte, size)); err == nil {
+		t.Fatal("out of bounds flash write succeeded")
+	}
+	if err := s.ReadFlash(end, make([]byte, size)); err == nil {
+		t.Fatal("out of bounds flash read succeeded")
+	}
+	if err := s.EraseFlashPage(end); err == nil {
+		t.Fatal("out of bounds flash erase succeeded")
+	}
+}
@@ -0,0 +1,36 @@
+package sim
+
+import (
+	"fmt"
+)
+
+func (s *SimAdapter) checkSRAM(start uint16, data []byte) error {
+	if int(start)+len(data) > len(s.core.data) {
+		return fmt.Errorf("debugwire: sim: sram access out of bounds: 0x%04x + 0x%04x > 0x%04x", start, len(data), len(s.core.data))
+	}
+	return nil
+}
+
+func (s *SimAdapter) WriteSRAM(start uint16, data []byte) error {
+	if err := s.checkSRAM(start, data); err != nil {
+		return err
+	}
+	return s.locked(func() error {
+		for i, b := range data {
+			s.core.write(start+uint16(i), b)
+		}
+		return nil
+	})
+}
+
+func (s *SimAdapter) ReadSRAM(start uint16, data []byte) error {
+	if err := s.checkSRAM(start, data); err != nil {
+		return err
+	}
+	return s.locked(func() error {
+		for i := range data {
+			data[i] = s.core.read(start + uint16(i))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,300 @@
+package sim
+
+import (
+	"context"
+	"time"
+
+	"github.com/dwtk/devices"
+	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/internal/logger"
+)
+
+const (
+	wireModeReadSRAM      = 0x00
+	wireModeReadRegisters = 0x01
+	wireModeReadFlash     = 0x02
+	wireModeWriteSRAM     = 0x04
+	wireModeWriteRegs     = 0x05
+)
+
+// WireBreak is received by the target instead of a break, as the lines of
+// simulated targets (e.g. ptys) can't transmit breaks.
+const WireBreak = byte(0x00)
+
+// WireConn is the target side of the serial line, e.g. the master side of a
+// pty.
+type WireConn interface {
+	Poll(timeout time.Duration) (bool, error)
+	ReadByte() (byte, error)
+	Write(b []byte) error
+}
+
+// WireTarget is a simulated target that speaks the debugWIRE serial
+// protocol, as seen from the host side of a one-wire USB serial adapter.
+type WireTarget struct {
+	core     *core
+	firmware string
+
+	conn       WireConn
+	pc         uint16
+	bp         uint16
+	ir         uint16
+	mode       byte
+	flags      byte
+	afterBreak bool
+	running    bool
+	skipBp     bool
+	disabled   bool
+}
+
+func NewWireTarget(spec string) (*WireTarget, error) {
+	c, fw, err := newCoreFromSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WireTarget{
+		core:     c,
+		firmware: fw,
+	}, nil
+}
+
+func (w *WireTarget) MCU() *devices.MCU {
+	return w.core.mcu
+}
+
+// Serve handles the debugWIRE protocol on conn, until the context is
+// cancelled.
+func (w *WireTarget) Serve(ctx context.Context, conn WireConn) error {
+	w.conn = conn
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		if w.running {
+			if err := w.runBatch(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		ok, err := conn.Poll(100 * time.Millisecond)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		b, err := w.read()
+		if err != nil {
+			return err
+		}
+		if err := w.handle(b); err != nil {
+			return err
+		}
+	}
+}
+
+func (w *WireTarget) read() (byte, error) {
+	b, err := w.conn.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	logger.Debug.Printf("<<< 0x%02x", b)
+
+	// one-wire: everything the host sends comes back to it
+	return b, w.conn.Write([]byte{b})
+}
+
+func (w *WireTarget) readWord() (uint16, error) {
+	h, err := w.read()
+	if err != nil {
+		return 0, err
+	}
+	l, err := w.read()
+	if err != nil {
+		return 0, err
+	}
+	return (uint16(h) << 8) | uint16(l), nil
+}
+
+func (w *WireTarget) write(b ...byte) error {
+	for _, c := range b {
+		logger.Debug.Printf(">>> 0x%02x", c)
+	}
+	return w.conn.Write(b)
+}
+
+func (w *WireTarget) halt(targetBreak bool) error {
+	w.running = false
+	w.afterBreak = true
+	if targetBreak {
+		return w.write(0x00, 0x55)
+	}
+	return w.write(0x55)
+}
+
+func (w *WireTarget) runBatch() error {
+	if w.core.run(batchSize, w.bp, w.flags&0x01 != 0, w.skipBp) {
+		return w.halt(true)
+	}
+	w.skipBp = false
+
+	ok, err := w.conn.Poll(0)
+	if err != nil || !ok {
+		return err
+	}
+	b, err := w.read()
+	if err != nil {
+		return err
+	}
+	if b == WireBreak {
+		return w.halt(false)
+	}
+	return nil
+}
+
+func (w *WireTarget) handle(b byte) error {
+	if w.disabled {
+		return nil
+	}
+
+	switch b {
+	case WireBreak:
+		return w.halt(false)
+
+	case 0x06:
+		w.core.fuses[avr.HIGH_FUSE] |= w.core.mcu.DWENMask()
+		w.disabled = true
+
+	case 0x07:
+		w.core.reset()
+		return w.halt(true)
+
+	case 0xf3:
+		sign := w.core.mcu.Signature()
+		return w.write(byte(sign>>8), byte(sign))
+
+	case 0xf0:
+		pc := w.core.pc
+		if w.afterBreak {
+			pc++
+		}
+		return w.write(byte(pc>>8), byte(pc))
+
+	case 0xd0:
+		v, err := w.readWord()
+		if err != nil {
+			return err
+		}
+		w.pc = v
+		w.core.pc = v % w.core.flashWords()
+		w.afterBreak = false
+
+	case 0xd1:
+		v, err := w.readWord()
+		if err != nil {
+			return err
+		}
+		w.bp = v
+
+	case 0xd2:
+		v, err := w.readWord()
+		if err != nil {
+			return err
+		}
+		w.ir = v
+
+	case 0xc2:
+		v, err := w.read()
+		if err != nil {
+			return err
+		}
+		w.mode = v
+
+	case 0x23:
+		pc := w.core.pc
+		w.core.exec(w.ir, 0)
+		w.core.pc = pc
+
+	case 0x20:
+		return w.memory()
+
+	case 0x30:
+		w.running = true
+		w.skipBp = true
+		w.afterBreak = false
+
+	case 0x31:
+		w.core.step()
+		return w.halt(true)
+
+	default:
+		if b&0x80 == 0 && b&0x40 != 0 {
+			w.flags = b
+		}
+	}
+
+	return nil
+}
+
+func (w *WireTarget) memory() error {
+	defer func() {
+		// memory operations use the program counter as loop counter.
+		w.core.pc = w.bp % w.core.flashWords()
+		w.afterBreak = false
+	}()
+
+	if w.bp < w.pc {
+		return nil
+	}
+
+	switch w.mode {
+	case wireModeReadRegisters:
+		for i := w.pc; i < w.bp && i < 32; i++ {
+			if err := w.write(w.core.reg(byte(i))); err != nil {
+				return err
+			}
+		}
+
+	case wireModeWriteRegs:
+		for i := w.pc; i < w.bp && i < 32; i++ {
+			v, err := w.read()
+			if err != nil {
+				return err
+			}
+			w.core.setReg(byte(i), v)
+		}
+
+	case wireModeReadSRAM, wireModeReadFlash:
+		for i := uint16(0); i < (w.bp-w.pc)/2; i++ {
+			z := w.core.regPair(30)
+			v := w.core.read(z)
+			if w.mode == wireModeReadFlash {
+				v = w.core.flashByte(z)
+			}
+			if err := w.write(v); err != nil {
+				return err
+			}
+			w.core.setRegPair(30, z+1)
+		}
+
+	case wireModeWriteSRAM:
+		for i := uint16(0); i < (w.bp-w.pc)/2; i++ {
+			v, err := w.read()
+			if err != nil {
+				return err
+			}
+			z := w.core.regPair(30)
+			w.core.write(z, v)
+			w.core.setRegPair(30, z+1)
+		}
+	}
+
+	return nil
+}
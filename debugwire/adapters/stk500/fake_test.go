@@ -0,0 +1,213 @@
+package stk500
+
+import (
+	"context"
+	"time"
+
+	"github.com/dwtk/devices"
+	"github.com/dwtk/dwtk/internal/logger"
+	"github.com/dwtk/dwtk/internal/usbserial/usbserialtest"
+)
+
+// FakeTarget is the target device behind a FakeBootloader. The simulator
+// adapter implements it.
+type FakeTarget interface {
+	ReadSignature() (uint16, error)
+	ReadFlash(start uint16, data []byte) error
+	WriteFlashPage(start uint16, data []byte) error
+	EraseFlashPage(start uint16) error
+	ReadEEPROM(start uint16, data []byte) error
+	WriteEEPROM(start uint16, data []byte) error
+}
+
+// FakeBootloader implements the STK500v1 protocol like Optiboot, on the
+// master side of a pty.
+type FakeBootloader struct {
+	target FakeTarget
+	mcu    *devices.MCU
+	pty    *usbserialtest.Pty
+	addr   uint16
+}
+
+func NewFakeBootloader(target FakeTarget) (*FakeBootloader, error) {
+	sign, err := target.ReadSignature()
+	if err != nil {
+		return nil, err
+	}
+	mcu, err := devices.GetBySignature(sign)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FakeBootloader{
+		target: target,
+		mcu:    mcu,
+	}, nil
+}
+
+func (f *FakeBootloader) MCU() *devices.MCU {
+	return f.mcu
+}
+
+// Serve handles the protocol until the context is cancelled.
+func (f *FakeBootloader) Serve(ctx context.Context, pty *usbserialtest.Pty) error {
+	f.pty = pty
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		ok, err := pty.Poll(100 * time.Millisecond)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		c, err := f.read()
+		if err != nil {
+			return err
+		}
+		if err := f.handle(c); err != nil {
+			return err
+		}
+	}
+}
+
+func (f *FakeBootloader) read() (byte, error) {
+	b, err := f.pty.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	logger.Debug.Printf("<<< 0x%02x", b)
+	return b, nil
+}
+
+func (f *FakeBootloader) readN(n int) ([]byte, error) {
+	rv := make([]byte, n)
+	for i := range rv {
+		b, err := f.read()
+		if err != nil {
+			return nil, err
+		}
+		rv[i] = b
+	}
+	return rv, nil
+}
+
+// reply checks the end of the command and sends the response.
+func (f *FakeBootloader) reply(data ...byte) error {
+	b, err := f.read()
+	if err != nil {
+		return err
+	}
+	if b != syncCrcEop {
+		return f.write(respNoSync)
+	}
+	return f.write(append(append([]byte{respInSync}, data...), respOk)...)
+}
+
+func (f *FakeBootloader) write(b ...byte) error {
+	for _, c := range b {
+		logger.Debug.Printf(">>> 0x%02x", c)
+	}
+	return f.pty.Write(b)
+}
+
+func (f *FakeBootloader) handle(c byte) error {
+	switch c {
+	case cmdGetSync, cmdEnterProgmode, cmdLeaveProgmode:
+		return f.reply()
+
+	case cmdGetParam:
+		p, err := f.read()
+		if err != nil {
+			return err
+		}
+		v := byte(0)
+		switch p {
+		case paramSwMajor:
+			v = 8
+		case paramSwMinor:
+			v = 3
+		}
+		return f.reply(v)
+
+	case cmdLoadAddress:
+		a, err := f.readN(2)
+		if err != nil {
+			return err
+		}
+		f.addr = (uint16(a[1]) << 8) | uint16(a[0])
+		return f.reply()
+
+	case cmdUniversal:
+		if _, err := f.readN(4); err != nil {
+			return err
+		}
+		return f.reply(0)
+
+	case cmdReadSign:
+		sign, err := f.target.ReadSignature()
+		if err != nil {
+			return err
+		}
+		return f.reply(0x1e, byte(sign>>8), byte(sign))
+
+	case cmdReadPage:
+		h, err := f.readN(3)
+		if err != nil {
+			return err
+		}
+		data := make([]byte, (uint16(h[0])<<8)|uint16(h[1]))
+		switch h[2] {
+		case memFlash:
+			err = f.target.ReadFlash(f.addr*2, data)
+		case memEEPROM:
+			err = f.target.ReadEEPROM(f.addr, data)
+		}
+		if err != nil {
+			return err
+		}
+		return f.reply(data...)
+
+	case cmdProgPage:
+		h, err := f.readN(3)
+		if err != nil {
+			return err
+		}
+		data, err := f.readN(int((uint16(h[0]) << 8) | uint16(h[1])))
+		if err != nil {
+			return err
+		}
+		switch h[2] {
+		case memFlash:
+			// like optiboot, erase the page and write it.
+			page := f.mcu.FlashPageSize()
+			start := (f.addr * 2) &^ (page - 1)
+			buf := make([]byte, page)
+			if err := f.target.ReadFlash(start, buf); err != nil {
+				return err
+			}
+			copy(buf[f.addr*2-start:], data)
+			if err := f.target.EraseFlashPage(start); err != nil {
+				return err
+			}
+			err = f.target.WriteFlashPage(start, buf)
+		case memEEPROM:
+			err = f.target.WriteEEPROM(f.addr, data)
+		}
+		if err != nil {
+			return err
+		}
+		return f.reply()
+	}
+
+	// unknown commands are answered with the sync error, like optiboot
+	// ignores them.
+	return f.write(respNoSync)
+}
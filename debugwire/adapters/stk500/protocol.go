@@ -0,0 +1,112 @@
+package stk500
+
+import (
+	"fmt"
+)
+
+// STK500v1 protocol subset implemented by Optiboot and other Arduino
+// bootloaders. See Atmel AVR061 application note.
+const (
+	respOk      = 0x10
+	respFailed  = 0x11
+	respInSync  = 0x14
+	respNoSync  = 0x15
+	syncCrcEop  = 0x20
+	cmdGetSync  = 0x30
+	cmdGetParam = 0x41
+
+	cmdEnterProgmode = 0x50
+	cmdLeaveProgmode = 0x51
+	cmdLoadAddress   = 0x55
+	cmdUniversal     = 0x56
+	cmdProgPage      = 0x64
+	cmdReadPage      = 0x74
+	cmdReadSign      = 0x75
+
+	paramSwMajor = 0x81
+	paramSwMinor = 0x82
+
+	memFlash  = 'F'
+	memEEPROM = 'E'
+
+	// the page size field is 16 bits, but bootloaders use a 256 bytes buffer.
+	maxBlockSize = 256
+)
+
+// command sends a command and reads its response, that is respLen bytes
+// between respInSync and respOk.
+func (s *Stk500Adapter) command(c []byte, respLen int) ([]byte, error) {
+	if err := s.device.Write(append(c, syncCrcEop)); err != nil {
+		return nil, err
+	}
+
+	b, err := s.device.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	if b == respNoSync {
+		return nil, fmt.Errorf("debugwire: stk500: command 0x%02x: bootloader out of sync", c[0])
+	}
+	if b != respInSync {
+		return nil, fmt.Errorf("debugwire: stk500: command 0x%02x: expected INSYNC, got 0x%02x", c[0], b)
+	}
+
+	rv := make([]byte, respLen)
+	if err := s.device.Read(rv); err != nil {
+		return nil, err
+	}
+
+	b, err = s.device.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	if b == respFailed {
+		return nil, fmt.Errorf("debugwire: stk500: command 0x%02x: failed", c[0])
+	}
+	if b != respOk {
+		return nil, fmt.Errorf("debugwire: stk500: command 0x%02x: expected OK, got 0x%02x", c[0], b)
+	}
+	return rv, nil
+}
+
+func (s *Stk500Adapter) sync() error {
+	var err error
+	for i := 0; i < 10; i++ {
+		if _, err = s.command([]byte{cmdGetSync}, 0); err == nil {
+			return nil
+		}
+		if err := s.device.Flush(); err != nil {
+			return err
+		}
+	}
+	return fmt.Errorf("debugwire: stk500: failed to sync with bootloader: %s", err)
+}
+
+func (s *Stk500Adapter) getParam(p byte) (byte, error) {
+	b, err := s.command([]byte{cmdGetParam, p}, 1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+// loadAddress takes a word address for flash and a byte address for EEPROM.
+func (s *Stk500Adapter) loadAddress(addr uint16) error {
+	_, err := s.command([]byte{cmdLoadAddress, byte(addr), byte(addr >> 8)}, 0)
+	return err
+}
+
+func (s *Stk500Adapter) readPage(mem byte, data []byte) error {
+	b, err := s.command([]byte{cmdReadPage, byte(len(data) >> 8), byte(len(data)), mem}, len(data))
+	if err != nil {
+		return err
+	}
+	copy(data, b)
+	return nil
+}
+
+func (s *Stk500Adapter) progPage(mem byte, data []byte) error {
+	c := append([]byte{cmdProgPage, byte(len(data) >> 8), byte(len(data)), mem}, data...)
+	_, err := s.command(c, 0)
+	return err
+}
@@ -0,0 +1,293 @@
+package stk500
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+	"github.com/dwtk/dwtk/internal/logger"
+	"github.com/dwtk/dwtk/internal/usbserial"
+)
+
+const DefaultBaudrate = 115200
+
+var (
+	errNotSupported = errors.New("debugwire: stk500: operation not supported by bootloader")
+)
+
+type Stk500Adapter struct {
+	device     *usbserial.UsbSerial
+	mcu        common.MCU
+	serialPort string
+	baudrate   uint32
+	version    [2]byte
+}
+
+func New(serialPort string, baudrate uint32) (*Stk500Adapter, error) {
+	if baudrate == 0 {
+		baudrate = DefaultBaudrate
+	}
+
+	u, err := usbserial.OpenRaw(serialPort, baudrate)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := &Stk500Adapter{
+		device:     u,
+		serialPort: serialPort,
+		baudrate:   baudrate,
+	}
+
+	if err := rv.reset(); err != nil {
+		u.Close()
+		return nil, err
+	}
+
+	if err := rv.sync(); err != nil {
+		u.Close()
+		return nil, err
+	}
+
+	for i, p := range []byte{paramSwMajor, paramSwMinor} {
+		rv.version[i], err = rv.getParam(p)
+		if err != nil {
+			u.Close()
+			return nil, err
+		}
+	}
+	logger.Debug.Printf(" * Detected STK500v1 bootloader %d.%d", rv.version[0], rv.version[1])
+
+	if _, err := rv.command([]byte{cmdEnterProgmode}, 0); err != nil {
+		u.Close()
+		return nil, err
+	}
+
+	return rv, nil
+}
+
+// reset pulses DTR/RTS, like the Arduino IDE does, to start the bootloader.
+func (s *Stk500Adapter) reset() error {
+	if err := s.device.SetDTRRTS(false); err != nil {
+		return err
+	}
+	time.Sleep(250 * time.Millisecond)
+	if err := s.device.SetDTRRTS(true); err != nil {
+		return err
+	}
+	time.Sleep(50 * time.Millisecond)
+	return s.device.Flush()
+}
+
+func (s *Stk500Adapter) Close() error {
+	return s.device.Close()
+}
+
+func (s *Stk500Adapter) Info() string {
+	return fmt.Sprintf("STK500v1 Bootloader %d.%d: %s\nBaud Rate: %d bps\n", s.version[0], s.version[1], s.serialPort, s.baudrate)
+}
+
+func (s *Stk500Adapter) ID() string {
+	return "stk500 " + s.serialPort
+}
+
+func (s *Stk500Adapter) Capabilities() common.Capabilities {
+	return common.CapMemory
+}
+
+func (s *Stk500Adapter) SetMCU(mcu common.MCU) {
+	s.mcu = mcu
+}
+
+func (s *Stk500Adapter) GetMCU() common.MCU {
+	return s.mcu
+}
+
+func (s *Stk500Adapter) ReadSignature() (uint16, error) {
+	b, err := s.command([]byte{cmdReadSign}, 3)
+	if err != nil {
+		return 0, err
+	}
+	if b[0] != 0x1e {
+		return 0, fmt.Errorf("debugwire: stk500: only devices manufactured by Atmel/Microchip are supported")
+	}
+	return (uint16(b[1]) << 8) | uint16(b[2]), nil
+}
+
+// ResetAndGo leaves the bootloader, that starts the application.
+func (s *Stk500Adapter) ResetAndGo() error {
+	_, err := s.command([]byte{cmdLeaveProgmode}, 0)
+	return err
+}
+
+func (s *Stk500Adapter) ReadFlash(start uint16, data []byte) error {
+	// flash is addressed by words, we read the whole words and slice.
+	begin := start &^ 1
+	end := start + uint16(len(data))
+	if end%2 != 0 {
+		end++
+	}
+
+	buf := make([]byte, end-begin)
+	for i := 0; i < len(buf); i += maxBlockSize {
+		block := buf[i:]
+		if len(block) > maxBlockSize {
+			block = block[:maxBlockSize]
+		}
+		if err := s.loadAddress((begin + uint16(i)) / 2); err != nil {
+			return err
+		}
+		if err := s.readPage(memFlash, block); err != nil {
+			return err
+		}
+	}
+
+	copy(data, buf[start-begin:])
+	return nil
+}
+
+// WriteFlashPage relies on the bootloader to erase the page before writing.
+func (s *Stk500Adapter) WriteFlashPage(start uint16, data []byte) error {
+	if err := s.loadAddress(start / 2); err != nil {
+		return err
+	}
+	return s.progPage(memFlash, data)
+}
+
+func (s *Stk500Adapter) EraseFlashPage(start uint16) error {
+	if s.mcu == nil {
+		return errors.New("debugwire: stk500: mcu not set")
+	}
+
+	page := make([]byte, s.mcu.FlashPageSize())
+	for i := range page {
+		page[i] = 0xff
+	}
+	return s.WriteFlashPage(start, page)
+}
+
+func (s *Stk500Adapter) ReadEEPROM(start uint16, data []byte) error {
+	for i := 0; i < len(data); i += maxBlockSize {
+		block := data[i:]
+		if len(block) > maxBlockSize {
+			block = block[:maxBlockSize]
+		}
+		if err := s.loadAddress(start + uint16(i)); err != nil {
+			return err
+		}
+		if err := s.readPage(memEEPROM, block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Stk500Adapter) WriteEEPROM(start uint16, data []byte) error {
+	for i := 0; i < len(data); i += maxBlockSize {
+		block := data[i:]
+		if len(block) > maxBlockSize {
+			block = block[:maxBlockSize]
+		}
+		if err := s.loadAddress(start + uint16(i)); err != nil {
+			return err
+		}
+		if err := s.progPage(memEEPROM, block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Stk500Adapter) Enable() error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) Disable() error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) SwitchMode(debugWIRE bool) error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) Reset() error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) ChipErase() error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) SendBreak() error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) RecvBreak() error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) Go() error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) Step() error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) Continue(hwBreakpoint uint16, hwBreakpointSet bool, timers bool) error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) Wait(ctx context.Context, c chan bool) error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) WriteInstruction(inst uint16) error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) SetPC(pc uint16) error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) GetPC() (uint16, error) {
+	return 0, errNotSupported
+}
+
+func (s *Stk500Adapter) WriteRegisters(start byte, regs []byte) error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) ReadRegisters(start byte, regs []byte) error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) WriteSRAM(start uint16, data []byte) error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) ReadSRAM(start uint16, data []byte) error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) ReadFuses() ([]byte, error) {
+	return nil, errNotSupported
+}
+
+func (s *Stk500Adapter) WriteLFuse(data byte) error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) WriteHFuse(data byte) error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) WriteEFuse(data byte) error {
+	return errNotSupported
+}
+
+func (s *Stk500Adapter) WriteLock(data byte) error {
+	return errNotSupported
+}
@@ -0,0 +1,78 @@
+package stk500
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dwtk/dwtk/debugwire/adapters/sim"
+	"github.com/dwtk/dwtk/internal/adaptertest"
+)
+
+// newTestAdapter connects an adapter to a fake bootloader served on a pty.
+func newTestAdapter(t *testing.T) (*Stk500Adapter, func()) {
+	t.Helper()
+
+	target, err := sim.New("atmega328p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake, err := NewFakeBootloader(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pty, cleanup := adaptertest.ServePty(t, fake.Serve)
+
+	a, err := New(pty.Name(), 0)
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	a.SetMCU(fake.MCU())
+
+	return a, func() {
+		a.Close()
+		cleanup()
+	}
+}
+
+func TestConformance(t *testing.T) {
+	adaptertest.Run(t, func(t *testing.T) (adaptertest.Adapter, func()) {
+		return newTestAdapter(t)
+	})
+}
+
+func TestLargeEEPROM(t *testing.T) {
+	a, cleanup := newTestAdapter(t)
+	defer cleanup()
+
+	// larger than a protocol block.
+	data := make([]byte, maxBlockSize+10)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	if err := a.WriteEEPROM(0x10, data); err != nil {
+		t.Fatal(err)
+	}
+	b := make([]byte, len(data))
+	if err := a.ReadEEPROM(0x10, b); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, data) {
+		t.Fatalf("bad eeprom: % x", b)
+	}
+}
+
+func TestNotSupported(t *testing.T) {
+	a, cleanup := newTestAdapter(t)
+	defer cleanup()
+
+	if _, err := a.ReadFuses(); err != errNotSupported {
+		t.Fatalf("fuses: %v", err)
+	}
+	if err := a.Step(); err != errNotSupported {
+		t.Fatalf("step: %v", err)
+	}
+	if err := a.SendBreak(); err != errNotSupported {
+		t.Fatalf("break: %v", err)
+	}
+}
@@ -0,0 +1,280 @@
+package stk500v2
+
+import (
+	"context"
+	"time"
+
+	"github.com/dwtk/devices"
+	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/internal/adaptertest"
+	"github.com/dwtk/dwtk/internal/logger"
+	"github.com/dwtk/dwtk/internal/usbserial/usbserialtest"
+)
+
+// FakeProgrammer implements the STK500v2 protocol like an AVRISP programmer,
+// on the master side of a pty, with SPI instructions emulated on top of a
+// target.
+type FakeProgrammer struct {
+	target adaptertest.FakeSpiTarget
+	spi    *adaptertest.FakeSpi
+	mcu    *devices.MCU
+	pty    *usbserialtest.Pty
+	addr   uint16
+}
+
+func NewFakeProgrammer(target adaptertest.FakeSpiTarget) (*FakeProgrammer, error) {
+	sign, err := target.ReadSignature()
+	if err != nil {
+		return nil, err
+	}
+	mcu, err := devices.GetBySignature(sign)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FakeProgrammer{
+		target: target,
+		spi:    adaptertest.NewFakeSpi(target),
+		mcu:    mcu,
+	}, nil
+}
+
+func (f *FakeProgrammer) MCU() *devices.MCU {
+	return f.mcu
+}
+
+// Serve handles the protocol until the context is cancelled.
+func (f *FakeProgrammer) Serve(ctx context.Context, pty *usbserialtest.Pty) error {
+	f.pty = pty
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		ok, err := pty.Poll(100 * time.Millisecond)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		b, err := f.read()
+		if err != nil {
+			return err
+		}
+		if b != messageStart {
+			continue
+		}
+
+		h, err := f.readN(4)
+		if err != nil {
+			return err
+		}
+		if h[3] != token {
+			continue
+		}
+		body, err := f.readN(((int(h[1]) << 8) | int(h[2])) + 1)
+		if err != nil {
+			return err
+		}
+
+		var answer []byte
+		if checksum(append(append([]byte{b}, h...), body...)) != 0 {
+			answer = []byte{answerChecksumError, statusChecksumError}
+		} else {
+			answer, err = f.handle(body[:len(body)-1])
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := f.write(frame(h[0], answer)); err != nil {
+			return err
+		}
+	}
+}
+
+func (f *FakeProgrammer) read() (byte, error) {
+	b, err := f.pty.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	logger.Debug.Printf("<<< 0x%02x", b)
+	return b, nil
+}
+
+func (f *FakeProgrammer) readN(n int) ([]byte, error) {
+	rv := make([]byte, n)
+	for i := range rv {
+		b, err := f.read()
+		if err != nil {
+			return nil, err
+		}
+		rv[i] = b
+	}
+	return rv, nil
+}
+
+func (f *FakeProgrammer) write(b []byte) error {
+	for _, c := range b {
+		logger.Debug.Printf(">>> 0x%02x", c)
+	}
+	return f.pty.Write(b)
+}
+
+func (f *FakeProgrammer) command(c ...byte) ([]byte, error) {
+	rv := make([]byte, 4)
+	if err := f.spi.Command(c, rv); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// dwenProgrammed reports if the target would refuse to enter SPI ISP mode.
+func (f *FakeProgrammer) dwenProgrammed() (bool, error) {
+	fuses, err := f.target.ReadFuses()
+	if err != nil {
+		return false, err
+	}
+	return fuses[avr.HIGH_FUSE]&f.mcu.DWENMask() == 0, nil
+}
+
+func (f *FakeProgrammer) handle(body []byte) ([]byte, error) {
+	if len(body) == 0 {
+		return []byte{0, statusCmdUnknown}, nil
+	}
+
+	ok := []byte{body[0], statusCmdOk}
+
+	switch body[0] {
+	case cmdSignOn:
+		sign := "STK500_2"
+		return append(append(ok, byte(len(sign))), sign...), nil
+
+	case cmdGetParameter:
+		v := byte(0)
+		switch body[1] {
+		case paramSwMajor:
+			v = 2
+		case paramSwMinor:
+			v = 10
+		}
+		return append(ok, v), nil
+
+	case cmdLoadAddress:
+		f.addr = (uint16(body[3]) << 8) | uint16(body[4])
+		return ok, nil
+
+	case cmdEnterProgmodeIsp:
+		dwen, err := f.dwenProgrammed()
+		if err != nil {
+			return nil, err
+		}
+		if dwen {
+			return []byte{body[0], statusCmdFailed}, nil
+		}
+		f.spi.Reset()
+		if _, err := f.command(body[8:12]...); err != nil {
+			return nil, err
+		}
+		return ok, nil
+
+	case cmdLeaveProgmodeIsp:
+		return ok, nil
+
+	case cmdChipEraseIsp:
+		if _, err := f.command(body[3:7]...); err != nil {
+			return nil, err
+		}
+		return ok, nil
+
+	case cmdProgramFlashIsp:
+		n := (int(body[1]) << 8) | int(body[2])
+		for i, d := range body[10 : 10+n] {
+			a := f.addr + uint16(i/2)
+			if _, err := f.command(body[5]|byte(i%2)<<3, byte(a>>8), byte(a), d); err != nil {
+				return nil, err
+			}
+		}
+		if body[3]&modePageWrite != 0 {
+			if _, err := f.command(body[6], byte(f.addr>>8), byte(f.addr), 0); err != nil {
+				return nil, err
+			}
+		}
+		f.addr += uint16(n / 2)
+		return ok, nil
+
+	case cmdReadFlashIsp:
+		n := (int(body[1]) << 8) | int(body[2])
+		rv := ok
+		for i := 0; i < n; i++ {
+			a := f.addr + uint16(i/2)
+			b, err := f.command(body[3]|byte(i%2)<<3, byte(a>>8), byte(a), 0)
+			if err != nil {
+				return nil, err
+			}
+			rv = append(rv, b[3])
+		}
+		f.addr += uint16(n / 2)
+		return append(rv, statusCmdOk), nil
+
+	case cmdProgramEEPROMIsp:
+		n := (int(body[1]) << 8) | int(body[2])
+		for i, d := range body[10 : 10+n] {
+			a := f.addr + uint16(i)
+			if _, err := f.command(body[5], byte(a>>8), byte(a), d); err != nil {
+				return nil, err
+			}
+		}
+		f.addr += uint16(n)
+		return ok, nil
+
+	case cmdReadEEPROMIsp:
+		n := (int(body[1]) << 8) | int(body[2])
+		rv := ok
+		for i := 0; i < n; i++ {
+			a := f.addr + uint16(i)
+			b, err := f.command(body[3], byte(a>>8), byte(a), 0)
+			if err != nil {
+				return nil, err
+			}
+			rv = append(rv, b[3])
+		}
+		f.addr += uint16(n)
+		return append(rv, statusCmdOk), nil
+
+	case cmdProgramFuseIsp, cmdProgramLockIsp:
+		if _, err := f.command(body[1:5]...); err != nil {
+			return nil, err
+		}
+		return append(ok, statusCmdOk), nil
+
+	case cmdReadFuseIsp, cmdReadLockIsp, cmdReadSignatureIsp:
+		b, err := f.command(body[2:6]...)
+		if err != nil {
+			return nil, err
+		}
+		return append(ok, b[(body[1]-1)%4], statusCmdOk), nil
+
+	case cmdSpiMulti:
+		b, err := f.command(body[4:8]...)
+		if err != nil {
+			return nil, err
+		}
+		rv := ok
+		for i := 0; i < int(body[2]); i++ {
+			v := byte(0)
+			if j := int(body[3]) + i; j < len(b) {
+				v = b[j]
+			}
+			rv = append(rv, v)
+		}
+		return append(rv, statusCmdOk), nil
+	}
+
+	return []byte{body[0], statusCmdUnknown}, nil
+}
@@ -0,0 +1,37 @@
+//go:build fixture
+// +build fixture
+
+package stk500v2
+
+import (
+	"testing"
+
+	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/debugwire/adapters/sim"
+	"github.com/dwtk/dwtk/internal/adaptertest"
+)
+
+// TestFixtureProgrammer serves a fake programmer, to be used with the
+// 'stk500v2' argument. See adaptertest.FixtureSpec.
+func TestFixtureProgrammer(t *testing.T) {
+	target, err := sim.New(adaptertest.FixtureSpec(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake, err := NewFakeProgrammer(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the simulated target starts with debugWIRE enabled, that would block
+	// SPI ISP.
+	fuses, err := target.ReadFuses()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := target.WriteHFuse(fuses[avr.HIGH_FUSE] | fake.MCU().DWENMask()); err != nil {
+		t.Fatal(err)
+	}
+
+	adaptertest.ServeFixture(t, fake.MCU().Name()+" programmer", fake.Serve)
+}
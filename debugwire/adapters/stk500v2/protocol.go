@@ -0,0 +1,202 @@
+package stk500v2
+
+import (
+	"fmt"
+
+	"github.com/dwtk/dwtk/internal/usbserial"
+)
+
+// STK500v2 protocol subset used for SPI ISP programming. See Atmel AVR068
+// application note.
+const (
+	messageStart = 0x1b
+	token        = 0x0e
+
+	cmdSignOn            = 0x01
+	cmdGetParameter      = 0x03
+	cmdLoadAddress       = 0x06
+	cmdEnterProgmodeIsp  = 0x10
+	cmdLeaveProgmodeIsp  = 0x11
+	cmdChipEraseIsp      = 0x12
+	cmdProgramFlashIsp   = 0x13
+	cmdReadFlashIsp      = 0x14
+	cmdProgramEEPROMIsp  = 0x15
+	cmdReadEEPROMIsp     = 0x16
+	cmdProgramFuseIsp    = 0x17
+	cmdReadFuseIsp       = 0x18
+	cmdProgramLockIsp    = 0x19
+	cmdReadLockIsp       = 0x1a
+	cmdReadSignatureIsp  = 0x1b
+	cmdSpiMulti          = 0x1d
+	answerChecksumError  = 0xb0
+	paramSwMajor         = 0x91
+	paramSwMinor         = 0x92
+	statusCmdOk          = 0x00
+	statusCmdTimeout     = 0x80
+	statusRdyBsyTimeout  = 0x81
+	statusCmdFailed      = 0xc0
+	statusChecksumError  = 0xc1
+	statusCmdUnknown     = 0xc9
+	modeWordRdyBsy       = 0x08
+	modePageRdyBsy       = 0x40
+	modePageWrite        = 0x80
+	pollRdyBsy           = 0x01
+	maxBlockSize         = 256
+	maxStartByteTimeouts = 10
+)
+
+var statusErrors = map[byte]string{
+	statusCmdTimeout:    "command timeout",
+	statusRdyBsyTimeout: "target busy timeout",
+	statusCmdFailed:     "command failed",
+	statusChecksumError: "checksum error",
+	statusCmdUnknown:    "unknown command",
+}
+
+func checksum(b []byte) byte {
+	rv := byte(0)
+	for _, c := range b {
+		rv ^= c
+	}
+	return rv
+}
+
+func frame(seq byte, body []byte) []byte {
+	rv := append([]byte{messageStart, seq, byte(len(body) >> 8), byte(len(body)), token}, body...)
+	return append(rv, checksum(rv))
+}
+
+// readFrame reads a message from the programmer, and returns its sequence
+// number and body. Programmers may take a while to answer commands that
+// wait for the target.
+func readFrame(u *usbserial.UsbSerial) (byte, []byte, error) {
+	start := byte(0)
+	for i := 0; ; i++ {
+		var err error
+		start, err = u.ReadByte()
+		if err == usbserial.ErrTimeout && i < maxStartByteTimeouts {
+			continue
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		if start == messageStart {
+			break
+		}
+	}
+
+	h := make([]byte, 4)
+	if err := u.Read(h); err != nil {
+		return 0, nil, err
+	}
+	if h[3] != token {
+		return 0, nil, fmt.Errorf("debugwire: stk500v2: invalid message token: 0x%02x", h[3])
+	}
+
+	body := make([]byte, ((int(h[1])<<8)|int(h[2]))+1)
+	if err := u.Read(body); err != nil {
+		return 0, nil, err
+	}
+
+	msg := append(append([]byte{start}, h...), body...)
+	if checksum(msg) != 0 {
+		return 0, nil, fmt.Errorf("debugwire: stk500v2: invalid message checksum")
+	}
+	return h[0], body[:len(body)-1], nil
+}
+
+// command sends a command to the programmer and returns the answer body,
+// after the command id and status.
+func (s *Stk500v2Adapter) command(body ...byte) ([]byte, error) {
+	s.seq++
+	if err := s.device.Write(frame(s.seq, body)); err != nil {
+		return nil, err
+	}
+
+	seq, rv, err := readFrame(s.device)
+	if err != nil {
+		return nil, err
+	}
+	if seq != s.seq {
+		return nil, fmt.Errorf("debugwire: stk500v2: command 0x%02x: invalid sequence number: expected %d, got %d", body[0], s.seq, seq)
+	}
+	if len(rv) == 2 && rv[0] == answerChecksumError {
+		return nil, fmt.Errorf("debugwire: stk500v2: command 0x%02x: programmer got invalid checksum", body[0])
+	}
+	if len(rv) < 2 || rv[0] != body[0] {
+		return nil, fmt.Errorf("debugwire: stk500v2: command 0x%02x: invalid answer: %v", body[0], rv)
+	}
+	if rv[1] != statusCmdOk {
+		if msg, ok := statusErrors[rv[1]]; ok {
+			return nil, fmt.Errorf("debugwire: stk500v2: command 0x%02x: %s", body[0], msg)
+		}
+		return nil, fmt.Errorf("debugwire: stk500v2: command 0x%02x: failed with status 0x%02x", body[0], rv[1])
+	}
+	return rv[2:], nil
+}
+
+func (s *Stk500v2Adapter) signOn() (string, error) {
+	var (
+		b   []byte
+		err error
+	)
+	for i := 0; i < 5; i++ {
+		b, err = s.command(cmdSignOn)
+		if err == nil {
+			break
+		}
+		if err := s.device.Flush(); err != nil {
+			return "", err
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("debugwire: stk500v2: failed to sign on: %s", err)
+	}
+	if len(b) < 1 || len(b) < int(b[0])+1 {
+		return "", fmt.Errorf("debugwire: stk500v2: invalid sign on answer: %v", b)
+	}
+	return string(b[1 : b[0]+1]), nil
+}
+
+func (s *Stk500v2Adapter) getParameter(p byte) (byte, error) {
+	b, err := s.command(cmdGetParameter, p)
+	if err != nil {
+		return 0, err
+	}
+	if len(b) < 1 {
+		return 0, fmt.Errorf("debugwire: stk500v2: invalid parameter answer")
+	}
+	return b[0], nil
+}
+
+// loadAddress takes a word address for flash and a byte address for EEPROM.
+func (s *Stk500v2Adapter) loadAddress(addr uint16) error {
+	_, err := s.command(cmdLoadAddress, 0, 0, byte(addr>>8), byte(addr))
+	return err
+}
+
+// spiMulti sends a raw SPI instruction to the target and returns the 4 bytes
+// shifted out.
+func (s *Stk500v2Adapter) spiMulti(c []byte) ([]byte, error) {
+	b, err := s.command(append([]byte{cmdSpiMulti, byte(len(c)), byte(len(c)), 0}, c...)...)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < len(c) {
+		return nil, fmt.Errorf("debugwire: stk500v2: invalid SPI answer: %v", b)
+	}
+	return b[:len(c)], nil
+}
+
+// readIsp runs the fuse, lock and signature read commands, that return the
+// byte shifted out by the target at position 4.
+func (s *Stk500v2Adapter) readIsp(cmd byte, c []byte) (byte, error) {
+	b, err := s.command(append([]byte{cmd, 4}, c...)...)
+	if err != nil {
+		return 0, err
+	}
+	if len(b) < 1 {
+		return 0, fmt.Errorf("debugwire: stk500v2: command 0x%02x: invalid answer", cmd)
+	}
+	return b[0], nil
+}
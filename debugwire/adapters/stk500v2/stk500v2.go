@@ -0,0 +1,408 @@
+package stk500v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+	"github.com/dwtk/dwtk/internal/logger"
+	"github.com/dwtk/dwtk/internal/usbserial"
+)
+
+const DefaultBaudrate = 115200
+
+var (
+	errNotSupported   = errors.New("debugwire: stk500v2: operation not supported: STK500v2 programmers only support SPI ISP mode, use a debugWIRE adapter")
+	errNoPageErase    = errors.New("debugwire: stk500v2: SPI ISP can't erase flash pages, use `dwtk erase-chip`")
+	errAlreadySpiMode = errors.New("debugwire: stk500v2: target device is already running on SPI ISP mode")
+)
+
+type Stk500v2Adapter struct {
+	device     *usbserial.UsbSerial
+	mcu        common.MCU
+	serialPort string
+	baudrate   uint32
+	signature  string
+	version    [2]byte
+	seq        byte
+}
+
+func New(serialPort string, baudrate uint32) (*Stk500v2Adapter, error) {
+	if baudrate == 0 {
+		baudrate = DefaultBaudrate
+	}
+
+	u, err := usbserial.OpenRaw(serialPort, baudrate)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := &Stk500v2Adapter{
+		device:     u,
+		serialPort: serialPort,
+		baudrate:   baudrate,
+	}
+
+	rv.signature, err = rv.signOn()
+	if err != nil {
+		u.Close()
+		return nil, err
+	}
+
+	for i, p := range []byte{paramSwMajor, paramSwMinor} {
+		rv.version[i], err = rv.getParameter(p)
+		if err != nil {
+			u.Close()
+			return nil, err
+		}
+	}
+	logger.Debug.Printf(" * Detected STK500v2 programmer %s %d.%d", rv.signature, rv.version[0], rv.version[1])
+
+	if err := rv.enterProgmode(); err != nil {
+		u.Close()
+		return nil, err
+	}
+
+	return rv, nil
+}
+
+func (s *Stk500v2Adapter) enterProgmode() error {
+	pgmEnable := avr.SpiPgmEnable()
+
+	// timeout, stabDelay, cmdexeDelay, synchLoops, byteDelay, pollValue,
+	// pollIndex, as recommended by AVR068.
+	c := append([]byte{cmdEnterProgmodeIsp, 200, 100, 25, 32, 0, pgmEnable[1], 3}, pgmEnable...)
+	if _, err := s.command(c...); err != nil {
+		return fmt.Errorf("debugwire: stk500v2: failed to enter SPI ISP mode, is debugWIRE enabled?: %s", err)
+	}
+	return nil
+}
+
+func (s *Stk500v2Adapter) leaveProgmode() error {
+	_, err := s.command(cmdLeaveProgmodeIsp, 1, 1)
+	return err
+}
+
+func (s *Stk500v2Adapter) waitWrite() error {
+	for i := 100; i > 0; i-- { // 5 seconds timeout
+		b, err := s.spiMulti(avr.SpiPollRdyNotBusy())
+		if err != nil {
+			return err
+		}
+		if b[3]&0x01 == 0 {
+			return nil
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	return fmt.Errorf("debugwire: stk500v2: failed to wait for spi write")
+}
+
+func (s *Stk500v2Adapter) Close() error {
+	s.leaveProgmode()
+	return s.device.Close()
+}
+
+func (s *Stk500v2Adapter) Info() string {
+	return fmt.Sprintf("STK500v2 Programmer %s %d.%d: %s\nBaud Rate: %d bps\n",
+		s.signature, s.version[0], s.version[1], s.serialPort, s.baudrate)
+}
+
+func (s *Stk500v2Adapter) ID() string {
+	return "stk500v2 " + s.serialPort
+}
+
+func (s *Stk500v2Adapter) Capabilities() common.Capabilities {
+	return common.CapSpiIsp | common.CapFuseWrite | common.CapChipErase | common.CapNativeFuseRead | common.CapMemory
+}
+
+func (s *Stk500v2Adapter) SetMCU(mcu common.MCU) {
+	s.mcu = mcu
+}
+
+func (s *Stk500v2Adapter) GetMCU() common.MCU {
+	return s.mcu
+}
+
+// Enable programs the DWEN fuse, to hand the target over to a debugWIRE
+// adapter.
+func (s *Stk500v2Adapter) Enable() error {
+	if s.mcu == nil {
+		return errors.New("debugwire: stk500v2: mcu not set")
+	}
+	f, err := s.readIsp(cmdReadFuseIsp, avr.SpiReadHFuse())
+	if err != nil {
+		return err
+	}
+	if err := s.WriteHFuse(f &^ s.mcu.DWENMask()); err != nil {
+		return err
+	}
+	if err := s.leaveProgmode(); err != nil {
+		return err
+	}
+
+	fmt.Println("debugWIRE was enabled for target device. a target power cycle may be required,")
+	fmt.Println("and it can be used with a debugWIRE adapter now.")
+	return nil
+}
+
+func (s *Stk500v2Adapter) Disable() error {
+	return errAlreadySpiMode
+}
+
+func (s *Stk500v2Adapter) SwitchMode(debugWIRE bool) error {
+	if !debugWIRE {
+		return nil
+	}
+	return errNotSupported
+}
+
+func (s *Stk500v2Adapter) Reset() error {
+	if err := s.leaveProgmode(); err != nil {
+		return err
+	}
+	return s.enterProgmode()
+}
+
+func (s *Stk500v2Adapter) ReadSignature() (uint16, error) {
+	rv := uint16(0)
+	for i := byte(0); i < 3; i++ {
+		b, err := s.readIsp(cmdReadSignatureIsp, avr.SpiReadSignature(i))
+		if err != nil {
+			return 0, err
+		}
+		if i == 0 {
+			if b != 0x1e {
+				return 0, fmt.Errorf("debugwire: stk500v2: only devices manufactured by Atmel/Microchip are supported")
+			}
+			continue
+		}
+		rv = (rv << 8) | uint16(b)
+	}
+	return rv, nil
+}
+
+func (s *Stk500v2Adapter) ChipErase() error {
+	c := append([]byte{cmdChipEraseIsp, 45, pollRdyBsy}, avr.SpiChipErase()...)
+	_, err := s.command(c...)
+	return err
+}
+
+// ResetAndGo leaves programming mode, that releases the target reset.
+func (s *Stk500v2Adapter) ResetAndGo() error {
+	return s.leaveProgmode()
+}
+
+func (s *Stk500v2Adapter) ReadFlash(start uint16, data []byte) error {
+	// flash is addressed by words, we read the whole words and slice.
+	begin := start &^ 1
+	end := start + uint16(len(data))
+	if end%2 != 0 {
+		end++
+	}
+
+	buf := make([]byte, end-begin)
+	for i := 0; i < len(buf); i += maxBlockSize {
+		block := buf[i:]
+		if len(block) > maxBlockSize {
+			block = block[:maxBlockSize]
+		}
+		if err := s.loadAddress((begin + uint16(i)) / 2); err != nil {
+			return err
+		}
+		b, err := s.command(cmdReadFlashIsp, byte(len(block)>>8), byte(len(block)), avr.SpiReadProgramMemoryLow(0)[0])
+		if err != nil {
+			return err
+		}
+		if len(b) < len(block) {
+			return fmt.Errorf("debugwire: stk500v2: short flash read: %d", len(b))
+		}
+		copy(block, b)
+	}
+
+	copy(data, buf[start-begin:])
+	return nil
+}
+
+// WriteFlashPage can't erase the page before writing, the flash must be
+// erased with a chip erase first.
+func (s *Stk500v2Adapter) WriteFlashPage(start uint16, data []byte) error {
+	if len(data)%2 != 0 {
+		return fmt.Errorf("debugwire: stk500v2: flash page size must be even: %d", len(data))
+	}
+	if err := s.loadAddress(start / 2); err != nil {
+		return err
+	}
+	c := []byte{
+		cmdProgramFlashIsp,
+		byte(len(data) >> 8), byte(len(data)),
+		modePageWrite | modePageRdyBsy | 0x01, // page mode
+		10,
+		avr.SpiLoadProgramMemoryPageLow(0, 0)[0],
+		avr.SpiWriteProgramMemoryPage(0)[0],
+		avr.SpiReadProgramMemoryLow(0)[0],
+		0xff, 0x00,
+	}
+	_, err := s.command(append(c, data...)...)
+	return err
+}
+
+func (s *Stk500v2Adapter) EraseFlashPage(start uint16) error {
+	return errNoPageErase
+}
+
+func (s *Stk500v2Adapter) ReadEEPROM(start uint16, data []byte) error {
+	for i := 0; i < len(data); i += maxBlockSize {
+		block := data[i:]
+		if len(block) > maxBlockSize {
+			block = block[:maxBlockSize]
+		}
+		if err := s.loadAddress(start + uint16(i)); err != nil {
+			return err
+		}
+		b, err := s.command(cmdReadEEPROMIsp, byte(len(block)>>8), byte(len(block)), avr.SpiReadEEPROM(0)[0])
+		if err != nil {
+			return err
+		}
+		if len(b) < len(block) {
+			return fmt.Errorf("debugwire: stk500v2: short EEPROM read: %d", len(b))
+		}
+		copy(block, b)
+	}
+	return nil
+}
+
+func (s *Stk500v2Adapter) WriteEEPROM(start uint16, data []byte) error {
+	for i := 0; i < len(data); i += maxBlockSize {
+		block := data[i:]
+		if len(block) > maxBlockSize {
+			block = block[:maxBlockSize]
+		}
+		if err := s.loadAddress(start + uint16(i)); err != nil {
+			return err
+		}
+		c := []byte{
+			cmdProgramEEPROMIsp,
+			byte(len(block) >> 8), byte(len(block)),
+			modeWordRdyBsy, // byte mode
+			10,
+			avr.SpiWriteEEPROM(0, 0)[0],
+			0x00,
+			avr.SpiReadEEPROM(0)[0],
+			0xff, 0xff,
+		}
+		if _, err := s.command(append(c, block...)...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Stk500v2Adapter) ReadFuses() ([]byte, error) {
+	f := make([]byte, 4)
+	var err error
+	f[avr.LOW_FUSE], err = s.readIsp(cmdReadFuseIsp, avr.SpiReadLFuse())
+	if err != nil {
+		return nil, err
+	}
+	f[avr.LOCKBIT], err = s.readIsp(cmdReadLockIsp, avr.SpiReadLock())
+	if err != nil {
+		return nil, err
+	}
+	f[avr.EXTENDED_FUSE], err = s.readIsp(cmdReadFuseIsp, avr.SpiReadEFuse())
+	if err != nil {
+		return nil, err
+	}
+	f[avr.HIGH_FUSE], err = s.readIsp(cmdReadFuseIsp, avr.SpiReadHFuse())
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (s *Stk500v2Adapter) writeFuse(name string, cmd byte, c []byte, readCmd byte, read []byte) error {
+	if _, err := s.command(append([]byte{cmd}, c...)...); err != nil {
+		return err
+	}
+	if err := s.waitWrite(); err != nil {
+		return err
+	}
+	f, err := s.readIsp(readCmd, read)
+	if err != nil {
+		return err
+	}
+	if f != c[3] {
+		return fmt.Errorf("debugwire: stk500v2: failed to verify %s after writing: expected 0x%02x, got 0x%02x", name, c[3], f)
+	}
+	return nil
+}
+
+func (s *Stk500v2Adapter) WriteLFuse(data byte) error {
+	return s.writeFuse("lfuse", cmdProgramFuseIsp, avr.SpiWriteLFuse(data), cmdReadFuseIsp, avr.SpiReadLFuse())
+}
+
+func (s *Stk500v2Adapter) WriteHFuse(data byte) error {
+	return s.writeFuse("hfuse", cmdProgramFuseIsp, avr.SpiWriteHFuse(data), cmdReadFuseIsp, avr.SpiReadHFuse())
+}
+
+func (s *Stk500v2Adapter) WriteEFuse(data byte) error {
+	return s.writeFuse("efuse", cmdProgramFuseIsp, avr.SpiWriteEFuse(data), cmdReadFuseIsp, avr.SpiReadEFuse())
+}
+
+func (s *Stk500v2Adapter) WriteLock(data byte) error {
+	return s.writeFuse("lock", cmdProgramLockIsp, avr.SpiWriteLock(data), cmdReadLockIsp, avr.SpiReadLock())
+}
+
+func (s *Stk500v2Adapter) SendBreak() error {
+	return errNotSupported
+}
+
+func (s *Stk500v2Adapter) RecvBreak() error {
+	return errNotSupported
+}
+
+func (s *Stk500v2Adapter) Go() error {
+	return errNotSupported
+}
+
+func (s *Stk500v2Adapter) Step() error {
+	return errNotSupported
+}
+
+func (s *Stk500v2Adapter) Continue(hwBreakpoint uint16, hwBreakpointSet bool, timers bool) error {
+	return errNotSupported
+}
+
+func (s *Stk500v2Adapter) Wait(ctx context.Context, c chan bool) error {
+	return errNotSupported
+}
+
+func (s *Stk500v2Adapter) WriteInstruction(inst uint16) error {
+	return errNotSupported
+}
+
+func (s *Stk500v2Adapter) SetPC(pc uint16) error {
+	return errNotSupported
+}
+
+func (s *Stk500v2Adapter) GetPC() (uint16, error) {
+	return 0, errNotSupported
+}
+
+func (s *Stk500v2Adapter) WriteRegisters(start byte, regs []byte) error {
+	return errNotSupported
+}
+
+func (s *Stk500v2Adapter) ReadRegisters(start byte, regs []byte) error {
+	return errNotSupported
+}
+
+func (s *Stk500v2Adapter) WriteSRAM(start uint16, data []byte) error {
+	return errNotSupported
+}
+
+func (s *Stk500v2Adapter) ReadSRAM(start uint16, data []byte) error {
+	return errNotSupported
+}
@@ -0,0 +1,150 @@
+package stk500v2
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/debugwire/adapters/sim"
+	"github.com/dwtk/dwtk/internal/adaptertest"
+	"github.com/dwtk/dwtk/internal/usbserial/usbserialtest"
+)
+
+// serve serves a fake programmer for target on a pty.
+func serve(t *testing.T, target *sim.SimAdapter) (*FakeProgrammer, *usbserialtest.Pty, func()) {
+	t.Helper()
+
+	fake, err := NewFakeProgrammer(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pty, cleanup := adaptertest.ServePty(t, fake.Serve)
+	return fake, pty, cleanup
+}
+
+// newTestAdapter connects an adapter to a fake programmer, with the target
+// in SPI ISP mode.
+func newTestAdapter(t *testing.T) (*Stk500v2Adapter, *sim.SimAdapter, func()) {
+	t.Helper()
+
+	target, err := sim.New("atmega328p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake, pty, cleanup := serve(t, target)
+
+	fuses, err := target.ReadFuses()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := target.WriteHFuse(fuses[avr.HIGH_FUSE] | fake.MCU().DWENMask()); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := New(pty.Name(), 0)
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	a.SetMCU(fake.MCU())
+
+	return a, target, func() {
+		a.Close()
+		cleanup()
+	}
+}
+
+func TestConformance(t *testing.T) {
+	adaptertest.Run(t, func(t *testing.T) (adaptertest.Adapter, func()) {
+		a, _, cleanup := newTestAdapter(t)
+		return a, cleanup
+	})
+}
+
+func TestDebugWIREEnabled(t *testing.T) {
+	target, err := sim.New("atmega328p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, pty, cleanup := serve(t, target)
+	defer cleanup()
+
+	a, err := New(pty.Name(), 0)
+	if err == nil {
+		a.Close()
+		t.Fatal("entered SPI ISP mode with debugWIRE enabled")
+	}
+}
+
+// the flash is only erased with the chip, and the pages written must reach
+// the target.
+func TestFlash(t *testing.T) {
+	a, target, cleanup := newTestAdapter(t)
+	defer cleanup()
+
+	if err := a.EraseFlashPage(0); err != errNoPageErase {
+		t.Fatalf("page erase: %v", err)
+	}
+	if err := a.ChipErase(); err != nil {
+		t.Fatal(err)
+	}
+
+	page := a.mcu.FlashPageSize()
+	data := make([]byte, page)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	if err := a.WriteFlashPage(page, data); err != nil {
+		t.Fatal(err)
+	}
+	b := make([]byte, page)
+	if err := target.ReadFlash(page, b); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, data) {
+		t.Fatalf("bad target flash: % x", b)
+	}
+}
+
+func TestFuses(t *testing.T) {
+	a, target, cleanup := newTestAdapter(t)
+	defer cleanup()
+
+	if err := a.WriteLFuse(0xe2); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.WriteLock(0xfc); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := a.ReadFuses()
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp, err := target.ReadFuses()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(f, exp) {
+		t.Fatalf("bad fuses: % x != % x", f, exp)
+	}
+	if f[avr.LOW_FUSE] != 0xe2 || f[avr.LOCKBIT] != 0xfc {
+		t.Fatalf("fuses not written: % x", f)
+	}
+}
+
+func TestEnable(t *testing.T) {
+	a, target, cleanup := newTestAdapter(t)
+	defer cleanup()
+
+	if err := a.Enable(); err != nil {
+		t.Fatal(err)
+	}
+	f, err := target.ReadFuses()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f[avr.HIGH_FUSE]&a.mcu.DWENMask() != 0 {
+		t.Fatalf("DWEN not programmed: 0x%02x", f[avr.HIGH_FUSE])
+	}
+}
@@ -0,0 +1,47 @@
+package adapters
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// transcriptEntry is a single adapter call, stored as one JSON object per
+// line in transcript files.
+type transcriptEntry struct {
+	Method  string        `json:"method"`
+	Args    []string      `json:"args,omitempty"`
+	Data    string        `json:"data,omitempty"`
+	Value   uint16        `json:"value,omitempty"`
+	Text    string        `json:"text,omitempty"`
+	Error   string        `json:"error,omitempty"`
+	Elapsed time.Duration `json:"elapsed"`
+}
+
+func (e *transcriptEntry) String() string {
+	return fmt.Sprintf("%s(%v)", e.Method, e.Args)
+}
+
+func (e *transcriptEntry) err() error {
+	if e.Error == "" {
+		return nil
+	}
+	return errors.New(e.Error)
+}
+
+func (e *transcriptEntry) data() ([]byte, error) {
+	return hex.DecodeString(e.Data)
+}
+
+func transcriptArgs(args ...interface{}) []string {
+	rv := []string{}
+	for _, arg := range args {
+		if b, ok := arg.([]byte); ok {
+			rv = append(rv, hex.EncodeToString(b))
+			continue
+		}
+		rv = append(rv, fmt.Sprint(arg))
+	}
+	return rv
+}
@@ -0,0 +1,187 @@
+package adapters
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/debugwire/adapters/sim"
+)
+
+type session struct {
+	flash []byte
+	fuses []byte
+	pcs   []uint16
+}
+
+// runSession drives an adapter through flash, fuses, step and break
+// operations, and returns what was read back.
+func runSession(t *testing.T, a Adapter) *session {
+	t.Helper()
+
+	rv := &session{}
+
+	words, err := avr.Assemble(0, `
+	loop:
+		nop
+		nop
+		break
+		rjmp loop
+	`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page := make([]byte, 0x80)
+	for i, w := range words {
+		page[2*i] = byte(w)
+		page[2*i+1] = byte(w >> 8)
+	}
+
+	if err := a.EraseFlashPage(0); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.WriteFlashPage(0, page); err != nil {
+		t.Fatal(err)
+	}
+	rv.flash = make([]byte, len(page))
+	if err := a.ReadFlash(0, rv.flash); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.WriteLFuse(0x62); err != nil {
+		t.Fatal(err)
+	}
+	rv.fuses, err = a.ReadFuses()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getPC := func() {
+		pc, err := a.GetPC()
+		if err != nil {
+			t.Fatal(err)
+		}
+		rv.pcs = append(rv.pcs, pc)
+	}
+
+	if err := a.SetPC(0); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Step(); err != nil {
+		t.Fatal(err)
+	}
+	getPC()
+
+	if err := a.Continue(0, false, false); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	c := make(chan bool, 1)
+	if err := a.Wait(ctx, c); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-c:
+	default:
+		t.Fatal("target did not halt")
+	}
+	if err := a.RecvBreak(); err != nil {
+		t.Fatal(err)
+	}
+	getPC()
+
+	return rv
+}
+
+func tempPath(t *testing.T) (string, func()) {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "dwtk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return filepath.Join(dir, "session.dwtrace"), func() {
+		os.RemoveAll(dir)
+	}
+}
+
+func TestRecordReplay(t *testing.T) {
+	path, cleanup := tempPath(t)
+	defer cleanup()
+
+	s, err := sim.New("atmega328p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec, err := NewRecorder(s, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorded := runSession(t, rec)
+	if err := rec.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(recorded.flash[:8], []byte{0x00, 0x00, 0x00, 0x00, 0x98, 0x95, 0xfc, 0xcf}) {
+		t.Fatalf("bad flash: %v", recorded.flash[:8])
+	}
+	if recorded.fuses[avr.LOW_FUSE] != 0x62 {
+		t.Fatalf("bad fuses: %v", recorded.fuses)
+	}
+	if !reflect.DeepEqual(recorded.pcs, []uint16{2, 4}) {
+		t.Fatalf("bad pcs: %v", recorded.pcs)
+	}
+
+	rep, err := NewReplayer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayed := runSession(t, rep)
+	if err := rep.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(recorded, replayed) {
+		t.Fatalf("replay mismatch: %+v != %+v", recorded, replayed)
+	}
+}
+
+func TestReplayMismatch(t *testing.T) {
+	path, cleanup := tempPath(t)
+	defer cleanup()
+
+	s, err := sim.New("atmega328p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec, err := NewRecorder(s, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.SetPC(0x10); err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	rep, err := NewReplayer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rep.SetPC(0x20); err == nil {
+		t.Fatal("replay of different arguments succeeded")
+	}
+	if err := rep.SetPC(0x10); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rep.GetPC(); err == nil {
+		t.Fatal("replay of different method succeeded")
+	}
+}
@@ -0,0 +1,169 @@
+package updi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dwtk/devices"
+	"github.com/dwtk/dwtk/avr"
+)
+
+// Device describes an UPDI device with the version 0 NVM controller
+// (tinyAVR 0/1/2 and megaAVR 0 series). It implements common.MCU, with no
+// debugWIRE registers.
+type Device struct {
+	name           string
+	signature      uint16
+	flashSize      uint16
+	flashPageSize  uint16
+	flashStart     uint16
+	eepromSize     uint16
+	eepromPageSize uint16
+	sramStart      uint16
+	sramSize       uint16
+}
+
+// generated from the device ATDF files.
+var updiDevices = []*Device{
+	{"ATmega1608", 0x9427, 0x4000, 0x40, 0x4000, 0x0100, 0x20, 0x3800, 0x0800},
+	{"ATmega1609", 0x9426, 0x4000, 0x40, 0x4000, 0x0100, 0x20, 0x3800, 0x0800},
+	{"ATmega3208", 0x9530, 0x8000, 0x80, 0x4000, 0x0100, 0x40, 0x3000, 0x1000},
+	{"ATmega3209", 0x9531, 0x8000, 0x80, 0x4000, 0x0100, 0x40, 0x3000, 0x1000},
+	{"ATmega4808", 0x9650, 0xc000, 0x80, 0x4000, 0x0100, 0x40, 0x2800, 0x1800},
+	{"ATmega4809", 0x9651, 0xc000, 0x80, 0x4000, 0x0100, 0x40, 0x2800, 0x1800},
+	{"ATmega808", 0x9326, 0x2000, 0x40, 0x4000, 0x0100, 0x20, 0x3c00, 0x0400},
+	{"ATmega809", 0x932a, 0x2000, 0x40, 0x4000, 0x0100, 0x20, 0x3c00, 0x0400},
+	{"ATtiny1604", 0x9425, 0x4000, 0x40, 0x8000, 0x0100, 0x20, 0x3c00, 0x0400},
+	{"ATtiny1606", 0x9424, 0x4000, 0x40, 0x8000, 0x0100, 0x20, 0x3c00, 0x0400},
+	{"ATtiny1607", 0x9423, 0x4000, 0x40, 0x8000, 0x0100, 0x20, 0x3c00, 0x0400},
+	{"ATtiny1614", 0x9422, 0x4000, 0x40, 0x8000, 0x0100, 0x20, 0x3800, 0x0800},
+	{"ATtiny1616", 0x9421, 0x4000, 0x40, 0x8000, 0x0100, 0x20, 0x3800, 0x0800},
+	{"ATtiny1617", 0x9420, 0x4000, 0x40, 0x8000, 0x0100, 0x20, 0x3800, 0x0800},
+	{"ATtiny1624", 0x942a, 0x4000, 0x40, 0x8000, 0x0100, 0x20, 0x3800, 0x0800},
+	{"ATtiny1626", 0x9429, 0x4000, 0x40, 0x8000, 0x0100, 0x20, 0x3800, 0x0800},
+	{"ATtiny1627", 0x9428, 0x4000, 0x40, 0x8000, 0x0100, 0x20, 0x3800, 0x0800},
+	{"ATtiny202", 0x9123, 0x0800, 0x40, 0x8000, 0x0040, 0x20, 0x3f80, 0x0080},
+	{"ATtiny204", 0x9122, 0x0800, 0x40, 0x8000, 0x0040, 0x20, 0x3f80, 0x0080},
+	{"ATtiny212", 0x9121, 0x0800, 0x40, 0x8000, 0x0040, 0x20, 0x3f80, 0x0080},
+	{"ATtiny214", 0x9120, 0x0800, 0x40, 0x8000, 0x0040, 0x20, 0x3f80, 0x0080},
+	{"ATtiny3216", 0x9521, 0x8000, 0x80, 0x8000, 0x0100, 0x40, 0x3800, 0x0800},
+	{"ATtiny3217", 0x9522, 0x8000, 0x80, 0x8000, 0x0100, 0x40, 0x3800, 0x0800},
+	{"ATtiny402", 0x9227, 0x1000, 0x40, 0x8000, 0x0080, 0x20, 0x3f00, 0x0100},
+	{"ATtiny404", 0x9226, 0x1000, 0x40, 0x8000, 0x0080, 0x20, 0x3f00, 0x0100},
+	{"ATtiny406", 0x9225, 0x1000, 0x40, 0x8000, 0x0080, 0x20, 0x3f00, 0x0100},
+	{"ATtiny412", 0x9223, 0x1000, 0x40, 0x8000, 0x0080, 0x20, 0x3f00, 0x0100},
+	{"ATtiny414", 0x9222, 0x1000, 0x40, 0x8000, 0x0080, 0x20, 0x3f00, 0x0100},
+	{"ATtiny416", 0x9221, 0x1000, 0x40, 0x8000, 0x0080, 0x20, 0x3f00, 0x0100},
+	{"ATtiny417", 0x9220, 0x1000, 0x40, 0x8000, 0x0080, 0x20, 0x3f00, 0x0100},
+	{"ATtiny424", 0x922c, 0x1000, 0x40, 0x8000, 0x0080, 0x20, 0x3e00, 0x0200},
+	{"ATtiny426", 0x922b, 0x1000, 0x40, 0x8000, 0x0080, 0x20, 0x3e00, 0x0200},
+	{"ATtiny427", 0x922a, 0x1000, 0x40, 0x8000, 0x0080, 0x20, 0x3e00, 0x0200},
+	{"ATtiny804", 0x9325, 0x2000, 0x40, 0x8000, 0x0080, 0x20, 0x3e00, 0x0200},
+	{"ATtiny806", 0x9324, 0x2000, 0x40, 0x8000, 0x0080, 0x20, 0x3e00, 0x0200},
+	{"ATtiny807", 0x9323, 0x2000, 0x40, 0x8000, 0x0080, 0x20, 0x3e00, 0x0200},
+	{"ATtiny814", 0x9322, 0x2000, 0x40, 0x8000, 0x0080, 0x20, 0x3e00, 0x0200},
+	{"ATtiny816", 0x9321, 0x2000, 0x40, 0x8000, 0x0080, 0x20, 0x3e00, 0x0200},
+	{"ATtiny817", 0x9320, 0x2000, 0x40, 0x8000, 0x0080, 0x20, 0x3e00, 0x0200},
+	{"ATtiny824", 0x9329, 0x2000, 0x40, 0x8000, 0x0080, 0x20, 0x3c00, 0x0400},
+	{"ATtiny826", 0x9328, 0x2000, 0x40, 0x8000, 0x0080, 0x20, 0x3c00, 0x0400},
+	{"ATtiny827", 0x9327, 0x2000, 0x40, 0x8000, 0x0080, 0x20, 0x3c00, 0x0400},
+}
+
+func GetBySignature(signature uint16) (*Device, error) {
+	for _, d := range updiDevices {
+		if signature == d.signature {
+			return d, nil
+		}
+	}
+	return nil, fmt.Errorf("debugwire: updi: device lookup failed for signature: 0x%04x", signature)
+}
+
+func GetByName(name string) (*Device, error) {
+	lcName := strings.ToLower(name)
+	for _, d := range updiDevices {
+		if lcName == strings.ToLower(d.name) {
+			return d, nil
+		}
+	}
+	return nil, fmt.Errorf("debugwire: updi: device lookup failed for name: %s", name)
+}
+
+func (d *Device) Name() string {
+	return d.name
+}
+
+func (d *Device) Signature() uint16 {
+	return d.signature
+}
+
+func (d *Device) FlashPageSize() uint16 {
+	return d.flashPageSize
+}
+
+func (d *Device) FlashSize() uint16 {
+	return d.flashSize
+}
+
+func (d *Device) NRWWOffset() uint16 {
+	return 0
+}
+
+func (d *Device) EEPROMSize() uint16 {
+	return d.eepromSize
+}
+
+func (d *Device) EEPROMPageSize() uint16 {
+	return d.eepromPageSize
+}
+
+// FlashStart is the address of the flash mapped in the data space.
+func (d *Device) FlashStart() uint16 {
+	return d.flashStart
+}
+
+func (d *Device) SRAMStart() uint16 {
+	return d.sramStart
+}
+
+func (d *Device) SRAMSize() uint16 {
+	return d.sramSize
+}
+
+// Features returns the AVRxt instruction set, with JMP and CALL for devices
+// with more than 8KB of flash.
+func (d *Device) Features() avr.Features {
+	if d.flashSize > 0x2000 {
+		return avr.FeaturesAVRxt | avr.FeatureJMP
+	}
+	return avr.FeaturesAVRxt
+}
+
+func (d *Device) EEAR() devices.SFR {
+	return devices.SFR{}
+}
+
+func (d *Device) EEDR() devices.SFR {
+	return devices.SFR{}
+}
+
+func (d *Device) EECR() devices.SFR {
+	return devices.SFR{}
+}
+
+func (d *Device) SPMCSR() devices.SFR {
+	return devices.SFR{}
+}
+
+// SP and SREG are empty, as the target can't be debugged. The debugwire
+// package fails when they are used.
+func (d *Device) SP() devices.SFR {
+	return devices.SFR{}
+}
+
+func (d *Device) SREG() devices.SFR {
+	return devices.SFR{}
+}
+
+func (d *Device) DWENMask() byte {
+	return 0
+}
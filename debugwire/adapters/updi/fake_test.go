@@ -0,0 +1,450 @@
+package updi
+
+import (
+	"context"
+	"time"
+
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+	"github.com/dwtk/dwtk/firmware"
+	"github.com/dwtk/dwtk/internal/logger"
+	"github.com/dwtk/dwtk/internal/usbserial/usbserialtest"
+)
+
+const (
+	fakeStatusA  = 0x30
+	fakeSib      = "tinyAVR P:0D:1-3"
+	fakeFusesLen = lockbitAddr - fusesStart + 1
+)
+
+// FakeTarget is a model of an UPDI target device, as seen from the host side
+// of a one-wire USB serial adapter. It implements the UPDI instructions, the
+// keys and the version 0 NVM controller used by the adapter. It doesn't
+// execute instructions.
+type FakeTarget struct {
+	mcu      *Device
+	firmware string
+	sib      string
+
+	pty      *usbserialtest.Pty
+	sram     []byte
+	flash    []byte
+	eeprom   []byte
+	fuses    []byte
+	pageBuf  map[uint16]byte
+	nvmAddr  uint16
+	nvmData  byte
+	cs       [16]byte
+	keys     byte
+	ptr      uint16
+	repeat   int
+	progmode bool
+	inReset  bool
+	disabled bool
+}
+
+func NewFakeTarget(spec string) (*FakeTarget, error) {
+	name, file := common.SplitSimSpec(spec)
+
+	mcu, err := GetByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := &FakeTarget{
+		mcu:     mcu,
+		sib:     fakeSib,
+		sram:    make([]byte, 0x10000),
+		flash:   make([]byte, mcu.FlashSize()),
+		eeprom:  make([]byte, mcu.EEPROMSize()),
+		fuses:   make([]byte, fakeFusesLen),
+		pageBuf: make(map[uint16]byte),
+	}
+	for i := range rv.flash {
+		rv.flash[i] = 0xff
+	}
+	for i := range rv.eeprom {
+		rv.eeprom[i] = 0xff
+	}
+	rv.fuses[fuseOscCfg] = 0x02
+	rv.fuses[fuseSysCfg0] = 0xc4
+	rv.fuses[lockbitAddr-fusesStart] = lockUnlocked
+
+	if file == "" {
+		return rv, nil
+	}
+
+	fw, err := firmware.NewFromFile(file, mcu)
+	if err != nil {
+		return nil, err
+	}
+	copy(rv.flash, fw.Data)
+	rv.firmware = file
+	return rv, nil
+}
+
+func (f *FakeTarget) MCU() *Device {
+	return f.mcu
+}
+
+// Serve handles the UPDI protocol on the master side of the pty, until the
+// context is cancelled.
+func (f *FakeTarget) Serve(ctx context.Context, pty *usbserialtest.Pty) error {
+	f.pty = pty
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		ok, err := pty.Poll(10 * time.Millisecond)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		b, err := pty.ReadByte()
+		if err != nil {
+			return err
+		}
+		logger.Debug.Printf("<<< 0x%02x", b)
+
+		// breaks aren't echoed back to the host.
+		if b == usbserialtest.PtyBreak {
+			f.disabled = false
+			f.repeat = 0
+			continue
+		}
+		if err := f.write(b); err != nil {
+			return err
+		}
+		if f.disabled || b != synch {
+			continue
+		}
+
+		op, err := f.read()
+		if err != nil {
+			return err
+		}
+		if err := f.handle(op); err != nil {
+			return err
+		}
+	}
+}
+
+// read reads a byte from the host and echoes it back.
+func (f *FakeTarget) read() (byte, error) {
+	b, err := f.pty.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	logger.Debug.Printf("<<< 0x%02x", b)
+	return b, f.write(b)
+}
+
+func (f *FakeTarget) readN(n int) ([]byte, error) {
+	rv := make([]byte, n)
+	for i := range rv {
+		b, err := f.read()
+		if err != nil {
+			return nil, err
+		}
+		rv[i] = b
+	}
+	return rv, nil
+}
+
+func (f *FakeTarget) readValue(size byte) (uint16, error) {
+	b, err := f.readN(int(size) + 1)
+	if err != nil {
+		return 0, err
+	}
+	if size == 0 {
+		return uint16(b[0]), nil
+	}
+	return (uint16(b[1]) << 8) | uint16(b[0]), nil
+}
+
+func (f *FakeTarget) write(b ...byte) error {
+	for _, c := range b {
+		logger.Debug.Printf(">>> 0x%02x", c)
+	}
+	return f.pty.Write(b)
+}
+
+func (f *FakeTarget) handle(op byte) error {
+	size := op & 0x03
+	mode := (op >> 2) & 0x03
+
+	switch op & 0xf0 {
+	case opLds:
+		addr, err := f.readValue(mode)
+		if err != nil {
+			return err
+		}
+		for i := uint16(0); i <= uint16(size); i++ {
+			if err := f.write(f.load(addr + i)); err != nil {
+				return err
+			}
+		}
+
+	case opSts:
+		addr, err := f.readValue(mode)
+		if err != nil {
+			return err
+		}
+		if err := f.write(ack); err != nil {
+			return err
+		}
+		b, err := f.readN(int(size) + 1)
+		if err != nil {
+			return err
+		}
+		for i, v := range b {
+			f.store(addr+uint16(i), v)
+		}
+		return f.write(ack)
+
+	case opLd:
+		n := f.repeat + 1
+		f.repeat = 0
+		for i := 0; i < n; i++ {
+			for j := uint16(0); j <= uint16(size); j++ {
+				if err := f.write(f.load(f.ptr + j)); err != nil {
+					return err
+				}
+			}
+			if mode == 1 {
+				f.ptr += uint16(size) + 1
+			}
+		}
+
+	case opSt:
+		if mode == 2 {
+			v, err := f.readValue(size)
+			if err != nil {
+				return err
+			}
+			f.ptr = v
+			return f.write(ack)
+		}
+		n := f.repeat + 1
+		f.repeat = 0
+		for i := 0; i < n; i++ {
+			b, err := f.readN(int(size) + 1)
+			if err != nil {
+				return err
+			}
+			for j, v := range b {
+				f.store(f.ptr+uint16(j), v)
+			}
+			if mode == 1 {
+				f.ptr += uint16(size) + 1
+			}
+			if err := f.write(ack); err != nil {
+				return err
+			}
+		}
+
+	case opLdcs:
+		return f.write(f.loadCS(op & 0x0f))
+
+	case opStcs:
+		v, err := f.read()
+		if err != nil {
+			return err
+		}
+		f.storeCS(op&0x0f, v)
+
+	case opRepeat:
+		v, err := f.readValue(size)
+		if err != nil {
+			return err
+		}
+		f.repeat = int(v)
+
+	case opKey:
+		if op&0x04 != 0 {
+			return f.write([]byte(f.sib)...)
+		}
+		b, err := f.readN(8)
+		if err != nil {
+			return err
+		}
+		k := make([]byte, 8)
+		for i, c := range b {
+			k[7-i] = c
+		}
+		switch string(k) {
+		case string(keyNvmProgValue):
+			f.keys |= keyNvmProg
+		case string(keyNvmEraseValue):
+			f.keys |= keyChipErase
+		}
+	}
+
+	return nil
+}
+
+func (f *FakeTarget) locked() bool {
+	return f.fuses[lockbitAddr-fusesStart] != lockUnlocked
+}
+
+func (f *FakeTarget) loadCS(addr byte) byte {
+	switch addr {
+	case csStatusA:
+		return fakeStatusA
+	case csKeyStatus:
+		return f.keys
+	case csSysStatus:
+		rv := byte(0)
+		if f.progmode {
+			rv |= sysNvmProg
+		}
+		if f.locked() {
+			rv |= sysLockStatus
+		}
+		return rv
+	}
+	return f.cs[addr]
+}
+
+func (f *FakeTarget) storeCS(addr byte, v byte) {
+	switch addr {
+	case csCtrlB:
+		if v&ctrlBUpdiDis != 0 {
+			f.disabled = true
+			f.keys = 0
+			f.progmode = false
+			v &^= ctrlBUpdiDis
+		}
+
+	case csResetReq:
+		if v == resetSignal {
+			f.inReset = true
+			return
+		}
+		if f.inReset {
+			f.inReset = false
+			f.reset()
+		}
+		return
+	}
+	f.cs[addr] = v
+}
+
+// reset runs when the reset request is released, and applies the keys.
+func (f *FakeTarget) reset() {
+	if f.keys&keyChipErase != 0 {
+		f.keys &^= keyChipErase
+		f.chipErase()
+		f.fuses[lockbitAddr-fusesStart] = lockUnlocked
+	}
+	f.progmode = f.keys&keyNvmProg != 0 && !f.locked()
+	f.pageBuf = make(map[uint16]byte)
+}
+
+func (f *FakeTarget) chipErase() {
+	for i := range f.flash {
+		f.flash[i] = 0xff
+	}
+	for i := range f.eeprom {
+		f.eeprom[i] = 0xff
+	}
+}
+
+func (f *FakeTarget) inFlash(addr uint16) bool {
+	return addr >= f.mcu.FlashStart() && uint32(addr) < uint32(f.mcu.FlashStart())+uint32(f.mcu.FlashSize())
+}
+
+func (f *FakeTarget) inEEPROM(addr uint16) bool {
+	return addr >= eepromStart && addr < eepromStart+f.mcu.EEPROMSize()
+}
+
+func (f *FakeTarget) load(addr uint16) byte {
+	switch {
+	case f.inFlash(addr):
+		return f.flash[addr-f.mcu.FlashStart()]
+	case f.inEEPROM(addr):
+		return f.eeprom[addr-eepromStart]
+	case addr >= sigrowStart && addr < sigrowStart+3:
+		sign := []byte{0x1e, byte(f.mcu.Signature() >> 8), byte(f.mcu.Signature())}
+		return sign[addr-sigrowStart]
+	case addr >= fusesStart && addr <= lockbitAddr:
+		return f.fuses[addr-fusesStart]
+	case addr == nvmStatus:
+		return 0
+	}
+	return f.sram[addr]
+}
+
+func (f *FakeTarget) store(addr uint16, v byte) {
+	switch {
+	case f.inFlash(addr), f.inEEPROM(addr):
+		if f.progmode {
+			f.pageBuf[addr] = v
+		}
+	case addr == nvmCtrlA:
+		if f.progmode {
+			f.nvmCommand(v)
+		}
+	case addr == nvmData:
+		f.nvmData = v
+	case addr == nvmAddr:
+		f.nvmAddr = (f.nvmAddr & 0xff00) | uint16(v)
+	case addr == nvmAddr+1:
+		f.nvmAddr = (f.nvmAddr & 0x00ff) | (uint16(v) << 8)
+	default:
+		f.sram[addr] = v
+	}
+}
+
+func (f *FakeTarget) nvmCommand(cmd byte) {
+	switch cmd {
+	case nvmCmdWP, nvmCmdER, nvmCmdERWP:
+		if cmd != nvmCmdWP {
+			for addr := range f.pageBuf {
+				f.erase(addr)
+			}
+		}
+		for addr, v := range f.pageBuf {
+			if cmd == nvmCmdER {
+				break
+			}
+			if f.inFlash(addr) {
+				f.flash[addr-f.mcu.FlashStart()] &= v
+			} else {
+				f.eeprom[addr-eepromStart] &= v
+			}
+		}
+		f.pageBuf = make(map[uint16]byte)
+
+	case nvmCmdPBC:
+		f.pageBuf = make(map[uint16]byte)
+
+	case nvmCmdCHER:
+		f.chipErase()
+
+	case nvmCmdWFU:
+		if f.nvmAddr >= fusesStart && f.nvmAddr <= lockbitAddr {
+			f.fuses[f.nvmAddr-fusesStart] = f.nvmData
+		}
+	}
+}
+
+// erase erases the whole flash page, or just the EEPROM byte.
+func (f *FakeTarget) erase(addr uint16) {
+	if !f.inFlash(addr) {
+		f.eeprom[addr-eepromStart] = 0xff
+		return
+	}
+
+	page := f.mcu.FlashPageSize()
+	start := (addr - f.mcu.FlashStart()) &^ (page - 1)
+	for i := start; i < start+page; i++ {
+		f.flash[i] = 0xff
+	}
+}
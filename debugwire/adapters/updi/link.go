@@ -0,0 +1,266 @@
+package updi
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// UPDI physical/link layer instructions. See the UPDI chapter of any tinyAVR
+// 0/1-series datasheet.
+const (
+	synch = 0x55
+	ack   = 0x40
+
+	opLds    = 0x00
+	opSts    = 0x40
+	opLd     = 0x20
+	opSt     = 0x60
+	opLdcs   = 0x80
+	opStcs   = 0xc0
+	opRepeat = 0xa0
+	opKey    = 0xe0
+
+	addr16 = 0x04
+	data8  = 0x00
+	data16 = 0x01
+
+	ptrDeref    = 0x00
+	ptrDerefInc = 0x04
+	ptrAddr     = 0x08
+
+	keySib    = 0x04
+	keySize16 = 0x01
+
+	csStatusA     = 0x00
+	csCtrlA       = 0x02
+	csCtrlB       = 0x03
+	csKeyStatus   = 0x07
+	csResetReq    = 0x08
+	csSysStatus   = 0x0b
+	ctrlAIbdly    = 0x80
+	ctrlBUpdiDis  = 0x04
+	ctrlBCcDetDis = 0x08
+	keyNvmProg    = 0x10
+	keyChipErase  = 0x08
+	resetSignal   = 0x59
+	sysNvmProg    = 0x08
+	sysLockStatus = 0x01
+
+	maxRepeat = 256
+)
+
+var (
+	keyNvmProgValue  = []byte("NVMProg ")
+	keyNvmEraseValue = []byte("NVMErase")
+
+	errNoAck = errors.New("debugwire: updi: target did not acknowledge")
+)
+
+// init resets the link with a double break, and configures the guard time
+// and collision detection. The breaks are read back as garbage, that is
+// dropped after the target had time to process them.
+func (u *UpdiAdapter) init() error {
+	if err := u.device.Flush(); err != nil {
+		return err
+	}
+	for i := 0; i < 2; i++ {
+		if err := u.device.SendBreak(); err != nil {
+			return err
+		}
+	}
+	time.Sleep(20 * time.Millisecond)
+	if err := u.device.Flush(); err != nil {
+		return err
+	}
+
+	if err := u.stcs(csCtrlB, ctrlBCcDetDis); err != nil {
+		return err
+	}
+	if err := u.stcs(csCtrlA, ctrlAIbdly); err != nil {
+		return err
+	}
+
+	v, err := u.ldcs(csStatusA)
+	if err != nil {
+		return err
+	}
+	if v == 0 {
+		return fmt.Errorf("debugwire: updi: invalid status: 0x%02x", v)
+	}
+	u.revision = v >> 4
+	return nil
+}
+
+func (u *UpdiAdapter) waitAck() error {
+	b, err := u.device.ReadByte()
+	if err != nil {
+		return err
+	}
+	if b != ack {
+		return errNoAck
+	}
+	return nil
+}
+
+func (u *UpdiAdapter) ldcs(addr byte) (byte, error) {
+	if err := u.device.Write([]byte{synch, opLdcs | addr}); err != nil {
+		return 0, err
+	}
+	return u.device.ReadByte()
+}
+
+func (u *UpdiAdapter) stcs(addr byte, v byte) error {
+	if err := u.device.Write([]byte{synch, opStcs | addr, v}); err != nil {
+		return err
+	}
+	return u.device.Commit()
+}
+
+func (u *UpdiAdapter) lds(addr uint16) (byte, error) {
+	if err := u.device.Write([]byte{synch, opLds | addr16 | data8, byte(addr), byte(addr >> 8)}); err != nil {
+		return 0, err
+	}
+	return u.device.ReadByte()
+}
+
+func (u *UpdiAdapter) sts(addr uint16, v byte) error {
+	if err := u.device.Write([]byte{synch, opSts | addr16 | data8, byte(addr), byte(addr >> 8)}); err != nil {
+		return err
+	}
+	if err := u.waitAck(); err != nil {
+		return err
+	}
+	if err := u.device.Write([]byte{v}); err != nil {
+		return err
+	}
+	return u.waitAck()
+}
+
+func (u *UpdiAdapter) stsWord(addr uint16, v uint16) error {
+	if err := u.device.Write([]byte{synch, opSts | addr16 | data16, byte(addr), byte(addr >> 8)}); err != nil {
+		return err
+	}
+	if err := u.waitAck(); err != nil {
+		return err
+	}
+	if err := u.device.Write([]byte{byte(v), byte(v >> 8)}); err != nil {
+		return err
+	}
+	return u.waitAck()
+}
+
+func (u *UpdiAdapter) setPtr(addr uint16) error {
+	if err := u.device.Write([]byte{synch, opSt | ptrAddr | data16, byte(addr), byte(addr >> 8)}); err != nil {
+		return err
+	}
+	return u.waitAck()
+}
+
+func (u *UpdiAdapter) repeat(n int) error {
+	return u.device.Write([]byte{synch, opRepeat | data8, byte(n - 1)})
+}
+
+// readData reads a block of data space, using the pointer with repeat.
+func (u *UpdiAdapter) readData(start uint16, data []byte) error {
+	for i := 0; i < len(data); i += maxRepeat {
+		block := data[i:]
+		if len(block) > maxRepeat {
+			block = block[:maxRepeat]
+		}
+		if err := u.setPtr(start + uint16(i)); err != nil {
+			return err
+		}
+		if len(block) > 1 {
+			if err := u.repeat(len(block)); err != nil {
+				return err
+			}
+		}
+		if err := u.device.Write([]byte{synch, opLd | ptrDerefInc | data8}); err != nil {
+			return err
+		}
+		if err := u.device.Read(block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeData writes a block of data space, using the pointer with repeat.
+// Each byte is acknowledged by the target.
+func (u *UpdiAdapter) writeData(start uint16, data []byte) error {
+	for i := 0; i < len(data); i += maxRepeat {
+		block := data[i:]
+		if len(block) > maxRepeat {
+			block = block[:maxRepeat]
+		}
+		if err := u.setPtr(start + uint16(i)); err != nil {
+			return err
+		}
+		if len(block) > 1 {
+			if err := u.repeat(len(block)); err != nil {
+				return err
+			}
+		}
+		if err := u.device.Write([]byte{synch, opSt | ptrDerefInc | data8}); err != nil {
+			return err
+		}
+		for _, b := range block {
+			if err := u.device.Write([]byte{b}); err != nil {
+				return err
+			}
+			if err := u.waitAck(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// key sends a 64 bits key, that is transmitted least significant byte
+// first.
+func (u *UpdiAdapter) key(k []byte) error {
+	b := []byte{synch, opKey}
+	for i := len(k) - 1; i >= 0; i-- {
+		b = append(b, k[i])
+	}
+	if err := u.device.Write(b); err != nil {
+		return err
+	}
+	return u.device.Commit()
+}
+
+// readSib reads the System Information Block, e.g. "tinyAVR P:0D:1-3": the
+// device family, and the versions of the NVM controller and on-chip debugger.
+func (u *UpdiAdapter) readSib() (string, error) {
+	if err := u.device.Write([]byte{synch, opKey | keySib | keySize16}); err != nil {
+		return "", err
+	}
+	b := make([]byte, 16)
+	if err := u.device.Read(b); err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (u *UpdiAdapter) resetTarget() error {
+	if err := u.stcs(csResetReq, resetSignal); err != nil {
+		return err
+	}
+	return u.stcs(csResetReq, 0)
+}
+
+// waitSysStatus polls ASI_SYS_STATUS until the bits in mask match v.
+func (u *UpdiAdapter) waitSysStatus(mask byte, v byte) error {
+	for i := 0; i < 100; i++ {
+		s, err := u.ldcs(csSysStatus)
+		if err != nil {
+			return err
+		}
+		if s&mask == v {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return fmt.Errorf("debugwire: updi: timeout waiting for system status")
+}
@@ -0,0 +1,100 @@
+package updi
+
+import (
+	"fmt"
+	"time"
+)
+
+// NVMCTRL version 0 registers, and memory map of tinyAVR 0/1/2 and megaAVR 0
+// series.
+const (
+	nvmCtrlA       = 0x1000
+	nvmStatus      = 0x1002
+	nvmData        = 0x1006
+	nvmAddr        = 0x1008
+	nvmStatusFBusy = 0x01
+	nvmStatusEBusy = 0x02
+	nvmStatusWrErr = 0x04
+
+	nvmCmdWP   = 0x01
+	nvmCmdER   = 0x02
+	nvmCmdERWP = 0x03
+	nvmCmdPBC  = 0x04
+	nvmCmdCHER = 0x05
+	nvmCmdWFU  = 0x07
+
+	sigrowStart  = 0x1100
+	fusesStart   = 0x1280
+	lockbitAddr  = 0x128a
+	eepromStart  = 0x1400
+	fuseBodCfg   = 0x01
+	fuseOscCfg   = 0x02
+	fuseSysCfg0  = 0x05
+	lockUnlocked = 0xc5
+)
+
+func (u *UpdiAdapter) waitNvm() error {
+	for i := 0; i < 500; i++ {
+		s, err := u.lds(nvmStatus)
+		if err != nil {
+			return err
+		}
+		if s&nvmStatusWrErr != 0 {
+			return fmt.Errorf("debugwire: updi: nvm write error")
+		}
+		if s&(nvmStatusFBusy|nvmStatusEBusy) == 0 {
+			return nil
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return fmt.Errorf("debugwire: updi: timeout waiting for nvm controller")
+}
+
+func (u *UpdiAdapter) nvmCommand(cmd byte) error {
+	if err := u.waitNvm(); err != nil {
+		return err
+	}
+	if err := u.sts(nvmCtrlA, cmd); err != nil {
+		return err
+	}
+	return u.waitNvm()
+}
+
+// writePage fills the page buffer and runs cmd, that is expected to erase
+// and/or write the page.
+func (u *UpdiAdapter) writePage(start uint16, data []byte, cmd byte) error {
+	if err := u.nvmCommand(nvmCmdPBC); err != nil {
+		return err
+	}
+	if err := u.writeData(start, data); err != nil {
+		return err
+	}
+	return u.nvmCommand(cmd)
+}
+
+func (u *UpdiAdapter) writeFuse(idx uint16, v byte) error {
+	if err := u.enterProgmode(); err != nil {
+		return err
+	}
+	if err := u.waitNvm(); err != nil {
+		return err
+	}
+	if err := u.stsWord(nvmAddr, fusesStart+idx); err != nil {
+		return err
+	}
+	if err := u.sts(nvmData, v); err != nil {
+		return err
+	}
+	if err := u.nvmCommand(nvmCmdWFU); err != nil {
+		return err
+	}
+
+	r, err := u.lds(fusesStart + idx)
+	if err != nil {
+		return err
+	}
+	if r != v {
+		return fmt.Errorf("debugwire: updi: failed to write fuse 0x%02x: expected 0x%02x, got 0x%02x", idx, v, r)
+	}
+	return nil
+}
@@ -0,0 +1,398 @@
+package updi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+	"github.com/dwtk/dwtk/internal/logger"
+	"github.com/dwtk/dwtk/internal/usbserial"
+)
+
+const DefaultBaudrate = 115200
+
+var (
+	errNotSupported = errors.New("debugwire: updi: operation not supported by UPDI devices")
+	errLocked       = errors.New("debugwire: updi: target device is locked, it must be unlocked with a chip erase")
+	errMCU          = errors.New("debugwire: updi: mcu not set")
+	errDebug        = errors.New("debugwire: updi: debugging is not supported, the UPDI on-chip debugger is not documented")
+)
+
+// UpdiAdapter talks UPDI to tinyAVR 0/1/2 and megaAVR 0 series devices,
+// using a serial adapter with RX and TX connected to the UPDI pin through a
+// resistor, like the one used for debugWIRE. AVR-Dx devices are not
+// supported, as their version 2 NVM controller uses 24-bit addresses.
+//
+// The NVM operations run with the target in NVM programming mode, where the
+// CPU is held in reset. The on-chip debugger is not documented by Microchip,
+// so the target can't be halted, run or stepped.
+//
+// The fuses are mapped to the classic AVR fuse bytes as follows:
+//
+//	low fuse      OSCCFG
+//	high fuse     SYSCFG0
+//	extended fuse BODCFG
+//	lock          LOCKBIT
+type UpdiAdapter struct {
+	device     *usbserial.UsbSerial
+	mcu        *Device
+	serialPort string
+	baudrate   uint32
+	revision   byte
+	progmode   bool
+}
+
+func New(serialPort string, baudrate uint32) (*UpdiAdapter, error) {
+	if baudrate == 0 {
+		baudrate = DefaultBaudrate
+	}
+
+	u, err := usbserial.OpenUpdi(serialPort, baudrate)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := &UpdiAdapter{
+		device:     u,
+		serialPort: serialPort,
+		baudrate:   baudrate,
+	}
+
+	if err := rv.init(); err != nil {
+		u.Close()
+		return nil, fmt.Errorf("debugwire: updi: failed to initialize link, is the target connected?: %s", err)
+	}
+	logger.Debug.Printf(" * Detected UPDI revision %d", rv.revision)
+
+	if err := rv.checkNvmVersion(); err != nil {
+		u.Close()
+		return nil, err
+	}
+
+	return rv, nil
+}
+
+// checkNvmVersion checks that the target device has the version 0 NVM
+// controller, with 16-bit addresses.
+func (u *UpdiAdapter) checkNvmVersion() error {
+	sib, err := u.readSib()
+	if err != nil {
+		return err
+	}
+	logger.Debug.Printf(" * Detected UPDI device: %s", sib)
+
+	if sib[8:11] != "P:0" {
+		return fmt.Errorf("debugwire: updi: NVM controller not supported (%s): only tinyAVR 0/1/2 and megaAVR 0 series devices are supported, AVR-Dx devices are not", strings.TrimSpace(sib))
+	}
+	return nil
+}
+
+func (u *UpdiAdapter) enterProgmode() error {
+	if u.progmode {
+		return nil
+	}
+
+	s, err := u.ldcs(csSysStatus)
+	if err != nil {
+		return err
+	}
+	if s&sysNvmProg == 0 {
+		if err := u.key(keyNvmProgValue); err != nil {
+			return err
+		}
+		k, err := u.ldcs(csKeyStatus)
+		if err != nil {
+			return err
+		}
+		if k&keyNvmProg == 0 {
+			return fmt.Errorf("debugwire: updi: NVM programming key not accepted")
+		}
+		if err := u.resetTarget(); err != nil {
+			return err
+		}
+
+		s, err = u.ldcs(csSysStatus)
+		if err != nil {
+			return err
+		}
+		if s&sysLockStatus != 0 {
+			return errLocked
+		}
+		if err := u.waitSysStatus(sysNvmProg, sysNvmProg); err != nil {
+			return err
+		}
+	}
+
+	u.progmode = true
+	return nil
+}
+
+// leaveProgmode resets the target and reinitializes the link, that is
+// disabled to release the target from NVM programming mode.
+func (u *UpdiAdapter) leaveProgmode() error {
+	if !u.progmode {
+		return nil
+	}
+	if err := u.ResetAndGo(); err != nil {
+		return err
+	}
+	return u.init()
+}
+
+func (u *UpdiAdapter) Close() error {
+	if u.progmode {
+		u.ResetAndGo()
+	}
+	return u.device.Close()
+}
+
+func (u *UpdiAdapter) Info() string {
+	return fmt.Sprintf("UPDI revision %d: %s\nBaud Rate: %d bps\n", u.revision, u.serialPort, u.baudrate)
+}
+
+func (u *UpdiAdapter) ID() string {
+	return "updi " + u.serialPort
+}
+
+func (u *UpdiAdapter) Capabilities() common.Capabilities {
+	return common.CapFuseWrite | common.CapChipErase | common.CapNativeFuseRead | common.CapMemory
+}
+
+func (u *UpdiAdapter) SetMCU(mcu common.MCU) {
+	if d, ok := mcu.(*Device); ok {
+		u.mcu = d
+	}
+}
+
+func (u *UpdiAdapter) GetMCU() common.MCU {
+	if u.mcu == nil {
+		return nil
+	}
+	return u.mcu
+}
+
+func (u *UpdiAdapter) Enable() error {
+	return errNotSupported
+}
+
+func (u *UpdiAdapter) Disable() error {
+	return errNotSupported
+}
+
+func (u *UpdiAdapter) SwitchMode(debugWIRE bool) error {
+	return errNotSupported
+}
+
+func (u *UpdiAdapter) Reset() error {
+	return u.resetTarget()
+}
+
+func (u *UpdiAdapter) ReadSignature() (uint16, error) {
+	if err := u.enterProgmode(); err != nil {
+		return 0, err
+	}
+
+	b := make([]byte, 3)
+	if err := u.readData(sigrowStart, b); err != nil {
+		return 0, err
+	}
+	if b[0] != 0x1e {
+		return 0, fmt.Errorf("debugwire: updi: only devices manufactured by Atmel/Microchip are supported")
+	}
+	return (uint16(b[1]) << 8) | uint16(b[2]), nil
+}
+
+// ChipErase uses the chip erase key, that also works for locked devices.
+func (u *UpdiAdapter) ChipErase() error {
+	if err := u.key(keyNvmEraseValue); err != nil {
+		return err
+	}
+	k, err := u.ldcs(csKeyStatus)
+	if err != nil {
+		return err
+	}
+	if k&keyChipErase == 0 {
+		return fmt.Errorf("debugwire: updi: chip erase key not accepted")
+	}
+	if err := u.resetTarget(); err != nil {
+		return err
+	}
+	if err := u.waitSysStatus(sysLockStatus, 0); err != nil {
+		return err
+	}
+
+	u.progmode = false
+	return nil
+}
+
+// ResetAndGo resets the target and disables UPDI, that releases the CPU from
+// NVM programming mode.
+func (u *UpdiAdapter) ResetAndGo() error {
+	if err := u.resetTarget(); err != nil {
+		return err
+	}
+	if err := u.stcs(csCtrlB, ctrlBUpdiDis|ctrlBCcDetDis); err != nil {
+		return err
+	}
+	u.progmode = false
+	return nil
+}
+
+func (u *UpdiAdapter) SendBreak() error {
+	return errDebug
+}
+
+func (u *UpdiAdapter) RecvBreak() error {
+	return errDebug
+}
+
+func (u *UpdiAdapter) Go() error {
+	return errDebug
+}
+
+func (u *UpdiAdapter) Step() error {
+	return errDebug
+}
+
+func (u *UpdiAdapter) Continue(hwBreakpoint uint16, hwBreakpointSet bool, timers bool) error {
+	return errDebug
+}
+
+func (u *UpdiAdapter) Wait(ctx context.Context, c chan bool) error {
+	return errDebug
+}
+
+func (u *UpdiAdapter) WriteInstruction(inst uint16) error {
+	return errNotSupported
+}
+
+func (u *UpdiAdapter) SetPC(pc uint16) error {
+	return errDebug
+}
+
+func (u *UpdiAdapter) GetPC() (uint16, error) {
+	return 0, errDebug
+}
+
+func (u *UpdiAdapter) WriteRegisters(start byte, regs []byte) error {
+	return errDebug
+}
+
+func (u *UpdiAdapter) ReadRegisters(start byte, regs []byte) error {
+	return errDebug
+}
+
+func (u *UpdiAdapter) WriteSRAM(start uint16, data []byte) error {
+	if err := u.leaveProgmode(); err != nil {
+		return err
+	}
+	return u.writeData(start, data)
+}
+
+func (u *UpdiAdapter) ReadSRAM(start uint16, data []byte) error {
+	if err := u.leaveProgmode(); err != nil {
+		return err
+	}
+	return u.readData(start, data)
+}
+
+func (u *UpdiAdapter) ReadFlash(start uint16, data []byte) error {
+	if u.mcu == nil {
+		return errMCU
+	}
+	if err := u.enterProgmode(); err != nil {
+		return err
+	}
+	return u.readData(u.mcu.FlashStart()+start, data)
+}
+
+func (u *UpdiAdapter) WriteFlashPage(start uint16, data []byte) error {
+	if u.mcu == nil {
+		return errMCU
+	}
+	if err := u.enterProgmode(); err != nil {
+		return err
+	}
+	return u.writePage(u.mcu.FlashStart()+start, data, nvmCmdERWP)
+}
+
+// EraseFlashPage writes a single byte to the page buffer, to latch the page
+// address for the erase command.
+func (u *UpdiAdapter) EraseFlashPage(start uint16) error {
+	if u.mcu == nil {
+		return errMCU
+	}
+	if err := u.enterProgmode(); err != nil {
+		return err
+	}
+	return u.writePage(u.mcu.FlashStart()+start, []byte{0xff}, nvmCmdER)
+}
+
+func (u *UpdiAdapter) ReadEEPROM(start uint16, data []byte) error {
+	if err := u.enterProgmode(); err != nil {
+		return err
+	}
+	return u.readData(eepromStart+start, data)
+}
+
+// WriteEEPROM writes each EEPROM page separately. Only the bytes written to
+// the page buffer are erased and written.
+func (u *UpdiAdapter) WriteEEPROM(start uint16, data []byte) error {
+	if u.mcu == nil {
+		return errMCU
+	}
+	if err := u.enterProgmode(); err != nil {
+		return err
+	}
+
+	page := u.mcu.EEPROMPageSize()
+	for i := 0; i < len(data); {
+		addr := start + uint16(i)
+		n := int(page - addr%page)
+		if n > len(data)-i {
+			n = len(data) - i
+		}
+		if err := u.writePage(eepromStart+addr, data[i:i+n], nvmCmdERWP); err != nil {
+			return err
+		}
+		i += n
+	}
+	return nil
+}
+
+func (u *UpdiAdapter) ReadFuses() ([]byte, error) {
+	if err := u.enterProgmode(); err != nil {
+		return nil, err
+	}
+
+	f := make([]byte, lockbitAddr-fusesStart+1)
+	if err := u.readData(fusesStart, f); err != nil {
+		return nil, err
+	}
+
+	rv := make([]byte, 4)
+	rv[avr.LOW_FUSE] = f[fuseOscCfg]
+	rv[avr.HIGH_FUSE] = f[fuseSysCfg0]
+	rv[avr.EXTENDED_FUSE] = f[fuseBodCfg]
+	rv[avr.LOCKBIT] = f[lockbitAddr-fusesStart]
+	return rv, nil
+}
+
+func (u *UpdiAdapter) WriteLFuse(data byte) error {
+	return u.writeFuse(fuseOscCfg, data)
+}
+
+func (u *UpdiAdapter) WriteHFuse(data byte) error {
+	return u.writeFuse(fuseSysCfg0, data)
+}
+
+func (u *UpdiAdapter) WriteEFuse(data byte) error {
+	return u.writeFuse(fuseBodCfg, data)
+}
+
+func (u *UpdiAdapter) WriteLock(data byte) error {
+	return u.writeFuse(lockbitAddr-fusesStart, data)
+}
@@ -0,0 +1,108 @@
+package updi
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+	"github.com/dwtk/dwtk/internal/adaptertest"
+)
+
+// newTestAdapter connects an adapter to a fake target served on a pty.
+func newTestAdapter(t *testing.T) (*UpdiAdapter, func()) {
+	t.Helper()
+
+	target, err := NewFakeTarget("attiny1614")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pty, cleanup := adaptertest.ServePty(t, target.Serve)
+
+	a, err := New(pty.Name(), 0)
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	sign, err := a.ReadSignature()
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	if sign != target.MCU().Signature() {
+		cleanup()
+		t.Fatalf("bad signature: 0x%04x", sign)
+	}
+	a.SetMCU(target.MCU())
+
+	return a, func() {
+		a.Close()
+		cleanup()
+	}
+}
+
+func TestConformance(t *testing.T) {
+	adaptertest.Run(t, func(t *testing.T) (adaptertest.Adapter, func()) {
+		return newTestAdapter(t)
+	})
+}
+
+func TestFuses(t *testing.T) {
+	a, cleanup := newTestAdapter(t)
+	defer cleanup()
+
+	if err := a.WriteLFuse(0x01); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.WriteEFuse(0x04); err != nil {
+		t.Fatal(err)
+	}
+	f, err := a.ReadFuses()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f[avr.LOW_FUSE] != 0x01 || f[avr.HIGH_FUSE] != 0xc4 || f[avr.EXTENDED_FUSE] != 0x04 || f[avr.LOCKBIT] != lockUnlocked {
+		t.Fatalf("bad fuses: % x", f)
+	}
+}
+
+func TestDebugNotSupported(t *testing.T) {
+	a, cleanup := newTestAdapter(t)
+	defer cleanup()
+
+	if a.Capabilities().Has(common.CapDebugWIRE) {
+		t.Fatal("debugWIRE capability reported")
+	}
+	if _, err := a.GetPC(); err != errDebug {
+		t.Fatalf("pc: %v", err)
+	}
+	if err := a.Step(); err != errDebug {
+		t.Fatalf("step: %v", err)
+	}
+	if err := a.SendBreak(); err != errDebug {
+		t.Fatalf("break: %v", err)
+	}
+	if err := a.Wait(context.Background(), make(chan bool, 1)); err != errDebug {
+		t.Fatalf("wait: %v", err)
+	}
+}
+
+func TestNvmVersion2(t *testing.T) {
+	target, err := NewFakeTarget("attiny1614")
+	if err != nil {
+		t.Fatal(err)
+	}
+	target.sib = "AVR     P:2D:1-3"
+	pty, cleanup := adaptertest.ServePty(t, target.Serve)
+	defer cleanup()
+
+	a, err := New(pty.Name(), 0)
+	if err == nil {
+		a.Close()
+		t.Fatal("AVR-Dx device accepted")
+	}
+	if !strings.Contains(err.Error(), "AVR-Dx") {
+		t.Fatalf("bad error: %v", err)
+	}
+}
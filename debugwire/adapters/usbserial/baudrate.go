@@ -3,43 +3,224 @@ package usbserial
 import (
 	"fmt"
 
+	"github.com/dwtk/dwtk/internal/logger"
 	"github.com/dwtk/dwtk/internal/usbserial"
 )
 
-func detectBaudrate(serialPort string) (uint32, error) {
-	// max supported mcu frequency is 20MHz, there are faster AVRs, but they
-	// provide better capabilities than debugwire. we want the faster baudrate
-	// possible
-	for i := 20; i > 0; i-- {
-		baudrate := uint32((i * 1000000) / 128)
-		p, err := usbserial.Open(serialPort, baudrate)
+// debugWIRE runs at the target clock divided by 128. these are the usual
+// clock sources: crystals, internal RC oscillators (with and without CKDIV8)
+// and the 128kHz oscillator. the 128kHz oscillator with CKDIV8 would need
+// 125 bps, that usb-serial adapters can't do.
+var knownClocks = []uint32{
+	20000000, 18432000, 16000000, 14745600, 12000000, 11059200, 10000000,
+	9600000, 8000000, 7372800, 6000000, 4800000, 4000000, 3686400, 2000000,
+	1843200, 1200000, 1000000, 600000, 500000, 250000, 128000, 125000,
+}
+
+const (
+	minBaudrate = 125000 / 128
+	maxBaudrate = 20000000 / 128
+
+	// when no known clock works, but the target answered, we sweep the whole
+	// range with steps smaller than the tolerance of the uart.
+	sweepRatio = 1.04
+
+	fineStep     = 0.01
+	fineMaxSteps = 8
+	fineTrials   = 3
+)
+
+type detector struct {
+	port     *usbserial.UsbSerial
+	answered bool
+}
+
+// probe sends a break with the given baudrate, and if the target answers it
+// properly, reads its signature.
+func (d *detector) probe(baudrate uint32) (uint16, bool, error) {
+	if err := d.port.SetBaudrate(baudrate); err != nil {
+		return 0, false, err
+	}
+
+	if err := d.port.SendBreak(); err != nil {
+		return 0, false, err
+	}
+
+	// if devices are running on very low frequency (e.g. internal clock with
+	// CKDIV8 enabled) we may not even get a break response.
+	c, err := d.port.RecvBreak()
+	if err == usbserial.ErrTimeout {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	d.answered = true
+	if c != 0x55 {
+		return 0, false, nil
+	}
+
+	if err := d.port.Write([]byte{0xf3}); err != nil {
+		return 0, false, err
+	}
+	sign, err := d.port.ReadWord()
+	if err == usbserial.ErrTimeout || isDesync(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	if sign == 0x0000 || sign == 0xffff {
+		return 0, false, nil
+	}
+	return sign, true, nil
+}
+
+func (d *detector) score(baudrate uint32, sign uint16) (int, error) {
+	rv := 0
+	for i := 0; i < fineTrials; i++ {
+		s, ok, err := d.probe(baudrate)
 		if err != nil {
 			return 0, err
 		}
-
-		if err := p.SendBreak(); err != nil {
-			return 0, err
+		if ok && s == sign {
+			rv++
 		}
+	}
+	return rv, nil
+}
 
-		// if devices are running on very low frequency (e.g. internal clock with
-		// CKDIV8 enabled) we may not even get a break response.
-		c, err := p.RecvBreak()
-		if err != nil {
-			if err := p.Close(); err != nil {
+func fineBaudrate(baudrate uint32, step int) uint32 {
+	return uint32(float64(baudrate) * (1 + float64(step)*fineStep))
+}
+
+// refine searches for the baudrates around a working one that still work
+// reliably, and picks the one in the middle of the window, that is the
+// closest to the actual target baudrate.
+func (d *detector) refine(baudrate uint32, sign uint16) (uint32, error) {
+	scores := map[int]int{}
+	s, err := d.score(baudrate, sign)
+	if err != nil {
+		return 0, err
+	}
+	scores[0] = s
+
+	for _, dir := range []int{-1, 1} {
+		for i := 1; i <= fineMaxSteps; i++ {
+			b := fineBaudrate(baudrate, dir*i)
+			if b < minBaudrate {
+				break
+			}
+			s, err := d.score(b, sign)
+			if err != nil {
 				return 0, err
 			}
+			scores[dir*i] = s
+			if s == 0 {
+				break
+			}
+		}
+	}
+
+	max := 0
+	for _, s := range scores {
+		if s > max {
+			max = s
+		}
+	}
+	if max == 0 {
+		return baudrate, nil
+	}
+
+	lo, hi := fineMaxSteps, -fineMaxSteps
+	for i, s := range scores {
+		if s != max {
 			continue
 		}
+		if i < lo {
+			lo = i
+		}
+		if i > hi {
+			hi = i
+		}
+	}
+	logger.Debug.Printf(" * Baudrate window: %d - %d bps", fineBaudrate(baudrate, lo), fineBaudrate(baudrate, hi))
 
-		// we could reuse the instance, but we won't, for reproducibility reasons
-		if err := p.Close(); err != nil {
-			return 0, err
+	return uint32(float64(baudrate) * (1 + float64(lo+hi)/2*fineStep)), nil
+}
+
+func (d *detector) detect() (uint32, uint16, error) {
+	for _, clock := range knownClocks {
+		baudrate := clock / 128
+		sign, ok, err := d.probe(baudrate)
+		if err != nil {
+			return 0, 0, err
+		}
+		if ok {
+			b, err := d.refine(baudrate, sign)
+			return b, sign, err
+		}
+	}
+
+	if !d.answered {
+		return 0, 0, nil
+	}
+
+	// the target is there, but running with some unusual clock. slower
+	// baudrates first, because their breaks are long enough to be detected
+	// by any target.
+	for b := float64(minBaudrate); b <= maxBaudrate*sweepRatio; b *= sweepRatio {
+		sign, ok, err := d.probe(uint32(b))
+		if err != nil {
+			return 0, 0, err
 		}
+		if ok {
+			rv, err := d.refine(uint32(b), sign)
+			return rv, sign, err
+		}
+	}
+	return 0, 0, nil
+}
+
+// detectBaudrate detects the baudrate of the target connected to the serial
+// port. if useCache is true, the baudrates detected before for the port are
+// tried first, and the result is cached.
+func detectBaudrate(serialPort string, useCache bool) (uint32, error) {
+	p, err := usbserial.Open(serialPort, maxBaudrate)
+	if err != nil {
+		return 0, err
+	}
+	defer p.Close()
+
+	d := &detector{port: p}
 
-		if c == 0x55 {
-			return baudrate, nil
+	var cache *baudrateCache
+	if useCache {
+		cache = loadBaudrateCache(serialPort)
+		for _, e := range cache.forPort() {
+			sign, ok, err := d.probe(e.baudrate)
+			if err != nil {
+				return 0, err
+			}
+			if ok && sign == e.signature {
+				logger.Debug.Printf(" * Using cached baudrate for signature 0x%04x", sign)
+				return e.baudrate, nil
+			}
 		}
 	}
 
-	return 0, fmt.Errorf("debugwire: usbserial: failed to detect baudrate for serial port: %s", serialPort)
+	baudrate, sign, err := d.detect()
+	if err != nil {
+		return 0, err
+	}
+	if baudrate == 0 {
+		return 0, fmt.Errorf("debugwire: usbserial: failed to detect baudrate for serial port: %s", serialPort)
+	}
+
+	if cache != nil {
+		if err := cache.store(sign, baudrate); err != nil {
+			logger.Debug.Printf(" * Failed to cache baudrate: %s", err)
+		}
+	}
+	return baudrate, nil
 }
@@ -19,7 +19,12 @@ func (us *UsbSerialAdapter) RecvBreak() error {
 	}
 
 	if b != 0x55 {
-		return fmt.Errorf("debugwire: bad break received. expected 0x55, got 0x%02x", b)
+		// the target halted, but its baudrate changed. once resynced it is
+		// halted again, that is what we were waiting for.
+		if errr := us.resync(); errr != nil {
+			return fmt.Errorf("%s (resync failed: %s)", badBreakError(b), errr)
+		}
+		return nil
 	}
 
 	us.afterBreak = true
@@ -0,0 +1,137 @@
+package usbserial
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/dwtk/dwtk/internal/usbserial"
+)
+
+// detected baudrates are cached per serial port and target signature, one
+// entry per line: "<port> <signature> <baudrate>". newest entries are the
+// last ones.
+
+const baudrateCacheMaxEntries = 32
+
+type baudrateCacheEntry struct {
+	port      string
+	signature uint16
+	baudrate  uint32
+}
+
+type baudrateCache struct {
+	path    string
+	port    string
+	entries []*baudrateCacheEntry
+}
+
+func baudrateCachePath() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "dwtk", "baudrates")
+}
+
+// portId identifies the serial port in a way that survives replugging the
+// adapter, if possible.
+func portId(serialPort string) string {
+	p, err := usbserial.FindPort(serialPort)
+	if err != nil || p == nil {
+		return serialPort
+	}
+	if p.ById != "" {
+		return p.ById
+	}
+	if p.UsbPath != "" {
+		return "usb:" + p.UsbPath
+	}
+	return p.Device
+}
+
+// loadBaudrateCache never fails, invalid entries are just ignored.
+func loadBaudrateCache(serialPort string) *baudrateCache {
+	rv := &baudrateCache{
+		path: baudrateCachePath(),
+		port: portId(serialPort),
+	}
+	if rv.path == "" {
+		return rv
+	}
+
+	fp, err := os.Open(rv.path)
+	if err != nil {
+		return rv
+	}
+	defer fp.Close()
+
+	scanner := bufio.NewScanner(fp)
+	for scanner.Scan() {
+		f := strings.Fields(scanner.Text())
+		if len(f) != 3 {
+			continue
+		}
+		sign, err := strconv.ParseUint(f[1], 0, 16)
+		if err != nil {
+			continue
+		}
+		baudrate, err := strconv.ParseUint(f[2], 10, 32)
+		if err != nil || baudrate == 0 {
+			continue
+		}
+		rv.entries = append(rv.entries, &baudrateCacheEntry{
+			port:      f[0],
+			signature: uint16(sign),
+			baudrate:  uint32(baudrate),
+		})
+	}
+	return rv
+}
+
+// forPort returns the entries for the serial port, newest first.
+func (c *baudrateCache) forPort() []*baudrateCacheEntry {
+	rv := []*baudrateCacheEntry{}
+	for i := len(c.entries) - 1; i >= 0; i-- {
+		if c.entries[i].port == c.port {
+			rv = append(rv, c.entries[i])
+		}
+	}
+	return rv
+}
+
+func (c *baudrateCache) store(signature uint16, baudrate uint32) error {
+	if c.path == "" {
+		return fmt.Errorf("debugwire: usbserial: no cache directory available")
+	}
+
+	entries := []*baudrateCacheEntry{}
+	for _, e := range c.entries {
+		if e.port != c.port || e.signature != signature {
+			entries = append(entries, e)
+		}
+	}
+	entries = append(entries, &baudrateCacheEntry{
+		port:      c.port,
+		signature: signature,
+		baudrate:  baudrate,
+	})
+	if len(entries) > baudrateCacheMaxEntries {
+		entries = entries[len(entries)-baudrateCacheMaxEntries:]
+	}
+	c.entries = entries
+
+	var b strings.Builder
+	for _, e := range entries {
+		fmt.Fprintf(&b, "%s 0x%04x %d\n", e.port, e.signature, e.baudrate)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.path, []byte(b.String()), 0644)
+}
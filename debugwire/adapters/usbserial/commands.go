@@ -27,6 +27,16 @@ func (us *UsbSerialAdapter) Reset() error {
 }
 
 func (us *UsbSerialAdapter) ReadSignature() (uint16, error) {
+	var rv uint16
+	err := us.retry(func() error {
+		var err error
+		rv, err = us.readSignature()
+		return err
+	})
+	return rv, err
+}
+
+func (us *UsbSerialAdapter) readSignature() (uint16, error) {
 	if err := us.device.Write([]byte{0xf3}); err != nil {
 		return 0, err
 	}
@@ -5,6 +5,14 @@ import (
 	"time"
 
 	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+)
+
+var (
+	inSPMCSR  = common.NewSnippet("in r29, SPMCSR")
+	outSPMCSR = common.NewSnippet("out SPMCSR, r29")
+	spmInst   = common.NewSnippet("spm")
+	incZWord  = common.NewSnippet("adiw r30, 2")
 )
 
 func (us *UsbSerialAdapter) waitSPCMSR(mask byte) error {
@@ -13,7 +21,7 @@ func (us *UsbSerialAdapter) waitSPCMSR(mask byte) error {
 	}
 
 	for i := 0; i < 0xff; i++ {
-		if err := us.WriteInstruction(avr.IN(us.mcu.SPMCSR().Io8(), 29)); err != nil {
+		if err := common.Execute(us, inSPMCSR); err != nil {
 			return err
 		}
 		time.Sleep(5 * time.Millisecond) // FIXME
@@ -30,13 +38,13 @@ func (us *UsbSerialAdapter) waitSPCMSR(mask byte) error {
 }
 
 func (us *UsbSerialAdapter) spm() error {
-	if err := us.WriteInstruction(avr.OUT(us.mcu.SPMCSR().Io8(), 29)); err != nil {
+	if err := common.Execute(us, outSPMCSR); err != nil {
 		return err
 	}
 	if err := us.SetPC(us.mcu.NRWWOffset()); err != nil {
 		return err
 	}
-	return us.WriteInstruction(avr.SPM())
+	return common.Execute(us, spmInst)
 }
 
 func (us *UsbSerialAdapter) enableRWW() error {
@@ -90,7 +98,7 @@ func (us *UsbSerialAdapter) WriteFlashPage(start uint16, b []byte) error {
 		if err := us.spm(); err != nil {
 			return err
 		}
-		if err := us.WriteInstruction(avr.ADIW(30, 2)); err != nil {
+		if err := common.Execute(us, incZWord); err != nil {
 			return err
 		}
 	}
@@ -132,6 +140,12 @@ func (us *UsbSerialAdapter) EraseFlashPage(start uint16) error {
 }
 
 func (us *UsbSerialAdapter) ReadFlash(start uint16, b []byte) error {
+	return us.retry(func() error {
+		return us.readFlash(start, b)
+	})
+}
+
+func (us *UsbSerialAdapter) readFlash(start uint16, b []byte) error {
 	c := []byte{
 		byte(start), byte(start >> 8), // Z
 	}
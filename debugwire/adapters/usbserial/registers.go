@@ -12,6 +12,12 @@ func (us *UsbSerialAdapter) WriteRegisters(start byte, regs []byte) error {
 }
 
 func (us *UsbSerialAdapter) ReadRegisters(start byte, regs []byte) error {
+	return us.retry(func() error {
+		return us.readRegisters(start, regs)
+	})
+}
+
+func (us *UsbSerialAdapter) readRegisters(start byte, regs []byte) error {
 	c := []byte{
 		0x66,
 		0xd0, 0x00, start, // ignoring high byte because registers are 0-31
@@ -35,6 +41,16 @@ func (us *UsbSerialAdapter) SetPC(b uint16) error {
 }
 
 func (us *UsbSerialAdapter) GetPC() (uint16, error) {
+	var rv uint16
+	err := us.retry(func() error {
+		var err error
+		rv, err = us.getPC()
+		return err
+	})
+	return rv, err
+}
+
+func (us *UsbSerialAdapter) getPC() (uint16, error) {
 	if err := us.device.Write([]byte{0xf0}); err != nil {
 		return 0, err
 	}
@@ -0,0 +1,74 @@
+package usbserial
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dwtk/dwtk/internal/logger"
+	"github.com/dwtk/dwtk/internal/usbserial"
+)
+
+type badBreakError byte
+
+func (b badBreakError) Error() string {
+	return fmt.Sprintf("debugwire: bad break received. expected 0x55, got 0x%02x", byte(b))
+}
+
+// isDesync checks if the error is caused by the target running with a
+// different baudrate, e.g. after the firmware changed the clock prescaler.
+// timeouts are not desyncs, the target may just be running or unpowered.
+func isDesync(err error) bool {
+	var b badBreakError
+	return err == usbserial.ErrEcho || errors.As(err, &b)
+}
+
+// resync detects the baudrate again and reopens the serial port. the target is
+// left halted.
+func (us *UsbSerialAdapter) resync() error {
+	logger.Debug.Printf(" * debugWIRE desync detected at %d bps, detecting baudrate again", us.baudrate)
+
+	us.device.Close()
+
+	baudrate, err := detectBaudrate(us.serialPort, false)
+	if err != nil {
+		return err
+	}
+	logger.Debug.Printf(" * Detected baudrate: %d", baudrate)
+
+	u, err := usbserial.Open(us.serialPort, baudrate)
+	if err != nil {
+		return err
+	}
+	us.device = u
+	us.baudrate = baudrate
+
+	if err := us.device.SendBreak(); err != nil {
+		return err
+	}
+	b, err := us.device.RecvBreak()
+	if err != nil {
+		return err
+	}
+	if b != 0x55 {
+		return badBreakError(b)
+	}
+	us.afterBreak = true
+	return nil
+}
+
+// retry runs the read f, and if it fails due to a desync, resyncs and runs it
+// again. f must be idempotent. if writes of previous operations were still
+// buffered they may have partially run, and the original error is returned.
+func (us *UsbSerialAdapter) retry(f func() error) error {
+	pending := len(us.device.Pending()) > 0
+
+	err := f()
+	if err == nil || !isDesync(err) || pending {
+		return err
+	}
+
+	if errr := us.resync(); errr != nil {
+		return fmt.Errorf("%s (resync failed: %s)", err, errr)
+	}
+	return f()
+}
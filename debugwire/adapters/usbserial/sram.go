@@ -20,6 +20,12 @@ func (us *UsbSerialAdapter) WriteSRAM(start uint16, data []byte) error {
 }
 
 func (us *UsbSerialAdapter) ReadSRAM(start uint16, data []byte) error {
+	return us.retry(func() error {
+		return us.readSRAM(start, data)
+	})
+}
+
+func (us *UsbSerialAdapter) readSRAM(start uint16, data []byte) error {
 	c := []byte{
 		byte(start), byte(start >> 8),
 	}
@@ -5,8 +5,9 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/dwtk/devices"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 	"github.com/dwtk/dwtk/internal/logger"
+	"github.com/dwtk/dwtk/internal/selector"
 	"github.com/dwtk/dwtk/internal/usbserial"
 )
 
@@ -16,7 +17,7 @@ var (
 
 type UsbSerialAdapter struct {
 	device     *usbserial.UsbSerial
-	mcu        *devices.MCU
+	mcu        common.MCU
 	serialPort string
 	baudrate   uint32
 	afterBreak bool
@@ -24,27 +25,71 @@ type UsbSerialAdapter struct {
 
 func New(serialPort string, baudrate uint32) (*UsbSerialAdapter, error) {
 	var err error
-	if serialPort == "" {
-		devices, err := usbserial.ListDevices()
+	if serialPort == "" || selector.IsPattern(serialPort) {
+		ports, err := usbserial.ListPorts()
 		if err != nil {
 			return nil, err
 		}
 
-		if len(devices) == 0 {
+		// auto-detection only considers allowed ports, explicit rules
+		// consider all of them.
+		var allow usbserial.AllowList
+		if serialPort == "" {
+			allow, err = usbserial.LoadAllowList()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		matches := []string{}
+		ignored := []string{}
+		for _, port := range ports {
+			if allow != nil && !allow.Allows(port) {
+				logger.Debug.Printf(" * Ignoring serial port not allowed: %s", port.Device)
+				ignored = append(ignored, port.Device)
+				continue
+			}
+			match, err := selector.Match(serialPort, port.Device, port.UsbPath)
+			if err != nil {
+				return nil, err
+			}
+			if !match && port.ById != "" {
+				match, err = selector.Match(serialPort, port.ById, port.UsbPath)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if match {
+				matches = append(matches, port.Device)
+			}
+		}
+
+		if len(matches) == 0 {
+			if serialPort != "" {
+				return nil, fmt.Errorf("debugwire: usbserial: serial port not found: %s", serialPort)
+			}
+			if len(ignored) > 0 {
+				return nil, fmt.Errorf("debugwire: usbserial: no serial port allowed by %s, select one by device, glob or usb:PATH (see `dwtk adapters`), ignored: %s",
+					usbserial.AllowListPath(), strings.Join(ignored, ", "))
+			}
 			return nil, nil
 		}
-		if len(devices) > 1 {
-			return nil, fmt.Errorf("debugwire: usbserial: more than one dwtk device found. this is not supported: %s",
-				strings.Join(devices, ", "))
+		if len(matches) > 1 {
+			if serialPort == "" {
+				return nil, fmt.Errorf("debugwire: usbserial: more than one serial port found, select one by device, glob or usb:PATH, or restrict them in %s (see `dwtk adapters`): %s",
+					usbserial.AllowListPath(), strings.Join(matches, ", "))
+			}
+			return nil, fmt.Errorf("debugwire: usbserial: more than one serial port matches %q, select one by device, glob or usb:PATH (see `dwtk adapters`): %s",
+				serialPort, strings.Join(matches, ", "))
 		}
 
-		logger.Debug.Printf(" * Detected serial port: %s", devices[0])
-		serialPort = devices[0]
+		logger.Debug.Printf(" * Detected serial port: %s", matches[0])
+		serialPort = matches[0]
 	}
 
 	if baudrate == 0 {
 		var err error
-		baudrate, err = detectBaudrate(serialPort)
+		baudrate, err = detectBaudrate(serialPort, true)
 		if err != nil {
 			return nil, err
 		}
@@ -79,11 +124,19 @@ func (us *UsbSerialAdapter) Info() string {
 	return fmt.Sprintf("Serial Port (USB Serial): %s\nBaud Rate: %d bps\n", us.serialPort, us.baudrate)
 }
 
-func (us *UsbSerialAdapter) SetMCU(mcu *devices.MCU) {
+func (us *UsbSerialAdapter) ID() string {
+	return "usbserial " + us.serialPort
+}
+
+func (us *UsbSerialAdapter) Capabilities() common.Capabilities {
+	return common.CapDebugWIRE | common.CapTimers | common.CapMemory
+}
+
+func (us *UsbSerialAdapter) SetMCU(mcu common.MCU) {
 	us.mcu = mcu
 }
 
-func (us *UsbSerialAdapter) GetMCU() *devices.MCU {
+func (us *UsbSerialAdapter) GetMCU() common.MCU {
 	return us.mcu
 }
 
@@ -91,10 +144,23 @@ func (us *UsbSerialAdapter) Enable() error {
 	return errNotSupported
 }
 
+func (us *UsbSerialAdapter) SwitchMode(debugWIRE bool) error {
+	return errNotSupported
+}
+
 func (us *UsbSerialAdapter) ChipErase() error {
 	return errNotSupported
 }
 
+// EEPROM is accessed with instructions, by the debugwire package.
+func (us *UsbSerialAdapter) ReadEEPROM(start uint16, data []byte) error {
+	return errNotSupported
+}
+
+func (us *UsbSerialAdapter) WriteEEPROM(start uint16, data []byte) error {
+	return errNotSupported
+}
+
 func (us *UsbSerialAdapter) WriteLFuse(data byte) error {
 	return errNotSupported
 }
@@ -0,0 +1,41 @@
+package usbserial
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dwtk/dwtk/debugwire/adapters/sim"
+	"github.com/dwtk/dwtk/internal/adaptertest"
+	"github.com/dwtk/dwtk/internal/usbserial/usbserialtest"
+)
+
+// newTestAdapter connects an adapter to a simulated target served on a pty.
+func newTestAdapter(t *testing.T) (*UsbSerialAdapter, func()) {
+	t.Helper()
+
+	target, err := sim.NewWireTarget("atmega328p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pty, cleanup := adaptertest.ServePty(t, func(ctx context.Context, pty *usbserialtest.Pty) error {
+		return target.Serve(ctx, pty)
+	})
+
+	a, err := New(pty.Name(), 62500)
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	a.SetMCU(target.MCU())
+
+	return a, func() {
+		a.Close()
+		cleanup()
+	}
+}
+
+func TestConformance(t *testing.T) {
+	adaptertest.Run(t, func(t *testing.T) (adaptertest.Adapter, func()) {
+		return newTestAdapter(t)
+	})
+}
@@ -1,66 +1,255 @@
 package debugwire
 
 import (
+	"context"
 	"errors"
+	"sort"
 	"strings"
 
 	"github.com/dwtk/dwtk/avr"
 )
 
+// breakpoints keeps the breakpoints requested by the debugger, that are only
+// committed to the target when it resumes. One of them uses the hardware
+// breakpoint, and the others are written to flash as BREAK instructions, with
+// a single rewrite for each flash page that changed.
+type breakpoints struct {
+	requested []uint16
+	hw        uint16
+	hwSet     bool
+	patched   map[uint16]uint16
+
+	// temporary breakpoint, used to run to an address.
+	temp    uint16
+	tempSet bool
+}
+
+func newBreakpoints() *breakpoints {
+	return &breakpoints{
+		requested: []uint16{},
+		patched:   make(map[uint16]uint16),
+	}
+}
+
+// SetHwBreakpoint reserves the hardware breakpoint for addr. It fails if the
+// hardware breakpoint was already reserved.
 func (dw *DebugWIRE) SetHwBreakpoint(addr uint16) bool {
-	if dw.hwBreakpointSet {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+
+	if dw.breakpoints.hwSet {
 		return false
 	}
 
-	dw.hwBreakpointSet = true
-	dw.hwBreakpoint = addr
+	dw.breakpoints.hwSet = true
+	dw.breakpoints.hw = addr
 	return true
 }
 
 func (dw *DebugWIRE) ClearHwBreakpoint() {
-	dw.hwBreakpointSet = false
-	dw.hwBreakpoint = 0
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+
+	dw.breakpoints.hwSet = false
+	dw.breakpoints.hw = 0
 }
 
+// SetSwBreakpoint requests a breakpoint at addr. The hardware breakpoint is
+// used for it if available, when the target resumes.
 func (dw *DebugWIRE) SetSwBreakpoint(addr uint16) error {
-	f := make([]byte, 2)
-	if err := dw.ReadFlash(addr, f); err != nil {
-		return err
-	}
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
 
-	dw.swBreakpoints[addr] = (uint16(f[1]) << 8) | uint16(f[0])
-	return dw.WriteFlashInstruction(addr, avr.BREAK())
+	for _, a := range dw.breakpoints.requested {
+		if a == addr {
+			return nil
+		}
+	}
+	dw.breakpoints.requested = append(dw.breakpoints.requested, addr)
+	return nil
 }
 
 func (dw *DebugWIRE) ClearSwBreakpoint(addr uint16) error {
-	bp, ok := dw.swBreakpoints[addr]
-	if !ok {
-		return nil
-	}
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
 
-	if err := dw.WriteFlashInstruction(addr, bp); err != nil {
-		return err
+	for i, a := range dw.breakpoints.requested {
+		if a == addr {
+			dw.breakpoints.requested = append(dw.breakpoints.requested[:i], dw.breakpoints.requested[i+1:]...)
+			break
+		}
 	}
-
-	delete(dw.swBreakpoints, addr)
 	return nil
 }
 
+// ClearSwBreakpoints removes all the breakpoints and restores the flash
+// immediately.
 func (dw *DebugWIRE) ClearSwBreakpoints() error {
-	// this is used for recovery, so try to clear everything
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+
+	dw.breakpoints.requested = []uint16{}
+	_, _, err := dw.commitBreakpoints(nil)
+	return err
+}
+
+// isBreakpoint reports if there's a breakpoint at addr, requested or still
+// in flash.
+func (dw *DebugWIRE) isBreakpoint(addr uint16) bool {
+	if _, ok := dw.breakpoints.patched[addr]; ok {
+		return true
+	}
+	for _, a := range dw.breakpoints.requested {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// forgetBreakpoints drops the BREAK instructions in the flash range, that was
+// rewritten, from the breakpoints and the journal together, so that their
+// original instructions aren't restored over the new contents.
+func (dw *DebugWIRE) forgetBreakpoints(start uint16, size uint32) error {
+	for a := range dw.breakpoints.patched {
+		if a >= start && uint32(a) < uint32(start)+size {
+			delete(dw.breakpoints.patched, a)
+		}
+	}
+	return dw.journalDrop(start, size)
+}
+
+// HasSwBreakpoints reports if there are BREAK instructions written to flash.
+func (dw *DebugWIRE) HasSwBreakpoints() bool {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+
+	return len(dw.breakpoints.patched) > 0
+}
+
+// commitBreakpoints writes the requested breakpoints to the target, except
+// skip, and returns the hardware breakpoint to use. If the debugger didn't
+// reserve the hardware breakpoint, it goes to the temporary breakpoint, or to
+// the first breakpoint that isn't in flash yet, as moving a breakpoint out of
+// flash would cost a page rewrite.
+func (dw *DebugWIRE) commitBreakpoints(skip *uint16) (uint16, bool, error) {
+	bp := dw.breakpoints
+
+	hw, hwSet := bp.hw, bp.hwSet
+	flash := make(map[uint16]bool)
+	if bp.tempSet && !(hwSet && bp.temp == hw) {
+		if _, ok := bp.patched[bp.temp]; !hwSet && !ok {
+			hw, hwSet = bp.temp, true
+		} else {
+			flash[bp.temp] = true
+		}
+	}
+	for _, a := range bp.requested {
+		if skip != nil && a == *skip {
+			continue
+		}
+		if hwSet && a == hw {
+			continue
+		}
+		if _, ok := bp.patched[a]; !hwSet && !ok {
+			hw, hwSet = a, true
+			continue
+		}
+		flash[a] = true
+	}
+
+	page := dw.MCU.FlashPageSize()
+	pages := make(map[uint16]bool)
+	for a := range flash {
+		if _, ok := bp.patched[a]; !ok {
+			pages[a-a%page] = true
+		}
+	}
+	for a := range bp.patched {
+		if !flash[a] {
+			pages[a-a%page] = true
+		}
+	}
+
+	starts := []uint16{}
+	for p := range pages {
+		starts = append(starts, p)
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+
+	// this is also used for recovery, so try to commit every page.
 	errs := []string{}
-	for k := range dw.swBreakpoints {
-		if err := dw.ClearSwBreakpoint(k); err != nil {
+	for _, p := range starts {
+		if err := dw.commitBreakpointsPage(p, flash); err != nil {
 			errs = append(errs, err.Error())
 		}
 	}
 
 	if len(errs) > 0 {
-		return errors.New(strings.Join(errs, "; "))
+		return 0, false, errors.New(strings.Join(errs, "; "))
 	}
-	return nil
+	return hw, hwSet, nil
 }
 
-func (dw *DebugWIRE) HasSwBreakpoints() bool {
-	return len(dw.swBreakpoints) > 0
+func (dw *DebugWIRE) commitBreakpointsPage(start uint16, flash map[uint16]bool) error {
+	bp := dw.breakpoints
+	end := start + dw.MCU.FlashPageSize()
+
+	b := make([]byte, dw.MCU.FlashPageSize())
+	if err := dw.readRawFlash(context.Background(), start, b); err != nil {
+		return err
+	}
+
+	patched := make(map[uint16]uint16)
+	for a, inst := range bp.patched {
+		if a < start || a >= end {
+			continue
+		}
+		if flash[a] {
+			patched[a] = inst
+			continue
+		}
+		b[a-start] = byte(inst)
+		b[a-start+1] = byte(inst >> 8)
+	}
+
+	brk := avr.BREAK()
+	added := make(map[uint16]uint16)
+	for a := range flash {
+		if a < start || a >= end {
+			continue
+		}
+		if _, ok := bp.patched[a]; ok {
+			continue
+		}
+		added[a] = (uint16(b[a-start+1]) << 8) | uint16(b[a-start])
+		b[a-start] = byte(brk)
+		b[a-start+1] = byte(brk >> 8)
+	}
+
+	// the original instructions must be safe before touching the flash.
+	if err := dw.journalAdd(added); err != nil {
+		return err
+	}
+	for a, inst := range added {
+		patched[a] = inst
+	}
+
+	if err := dw.writeFlashPage(start, b); err != nil {
+		return err
+	}
+
+	restored := make(map[uint16]bool)
+	for a := range bp.patched {
+		if a >= start && a < end {
+			if _, ok := patched[a]; !ok {
+				restored[a] = true
+			}
+			delete(bp.patched, a)
+		}
+	}
+	for a, inst := range patched {
+		bp.patched[a] = inst
+	}
+	return dw.journalRemove(restored)
 }
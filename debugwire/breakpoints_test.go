@@ -0,0 +1,255 @@
+package debugwire
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/debugwire/adapters"
+)
+
+// countingAdapter records the flash page writes and the hardware breakpoints
+// used to resume the target, and counts the reads.
+type countingAdapter struct {
+	adapters.Adapter
+	pageWrites []uint16
+	pageErases []uint16
+	hw         []uint16
+	reads      int
+}
+
+func (c *countingAdapter) GetPC() (uint16, error) {
+	c.reads++
+	return c.Adapter.GetPC()
+}
+
+func (c *countingAdapter) ReadRegisters(start byte, regs []byte) error {
+	c.reads++
+	return c.Adapter.ReadRegisters(start, regs)
+}
+
+func (c *countingAdapter) ReadSRAM(start uint16, data []byte) error {
+	c.reads++
+	return c.Adapter.ReadSRAM(start, data)
+}
+
+func (c *countingAdapter) ReadFlash(start uint16, data []byte) error {
+	c.reads++
+	return c.Adapter.ReadFlash(start, data)
+}
+
+func (c *countingAdapter) WriteFlashPage(start uint16, data []byte) error {
+	c.pageWrites = append(c.pageWrites, start)
+	return c.Adapter.WriteFlashPage(start, data)
+}
+
+func (c *countingAdapter) EraseFlashPage(start uint16) error {
+	c.pageErases = append(c.pageErases, start)
+	return c.Adapter.EraseFlashPage(start)
+}
+
+func (c *countingAdapter) Continue(hwBreakpoint uint16, hwBreakpointSet bool, timers bool) error {
+	if hwBreakpointSet {
+		c.hw = append(c.hw, hwBreakpoint)
+	}
+	return c.Adapter.Continue(hwBreakpoint, hwBreakpointSet, timers)
+}
+
+const breakpointsProgram = `
+loop:
+	nop
+	nop
+	nop
+	rjmp loop
+`
+
+func newBreakpointsTarget(t *testing.T) (*DebugWIRE, *countingAdapter, []byte) {
+	t.Helper()
+
+	dw := newTestDebugWIRE(t, breakpointsProgram)
+	orig := make([]byte, 8)
+	if err := dw.ReadFlash(context.Background(), 0, orig); err != nil {
+		t.Fatal(err)
+	}
+	c := &countingAdapter{Adapter: dw.adapter}
+	dw.adapter = c
+	return dw, c, orig
+}
+
+func rawFlashWord(t *testing.T, dw *DebugWIRE, addr uint16) uint16 {
+	t.Helper()
+
+	b := make([]byte, 2)
+	if err := dw.adapter.ReadFlash(addr, b); err != nil {
+		t.Fatal(err)
+	}
+	return (uint16(b[1]) << 8) | uint16(b[0])
+}
+
+func TestBreakpointsSamePage(t *testing.T) {
+	dw, c, orig := newBreakpointsTarget(t)
+	defer dw.Close()
+
+	if !dw.SetHwBreakpoint(0x7ffe) {
+		t.Fatal("hardware breakpoint not available")
+	}
+	for _, a := range []uint16{2, 4} {
+		if err := dw.SetSwBreakpoint(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	continueToHalt(t, dw)
+	checkPC(t, dw, 2)
+
+	if !reflect.DeepEqual(c.pageWrites, []uint16{0}) || len(c.pageErases) != 0 {
+		t.Fatalf("bad page rewrites: writes %v, erases %v", c.pageWrites, c.pageErases)
+	}
+	for _, a := range []uint16{2, 4} {
+		if w := rawFlashWord(t, dw, a); w != avr.BREAK() {
+			t.Fatalf("breakpoint not in flash at 0x%04x: 0x%04x", a, w)
+		}
+	}
+
+	// the breakpoints are hidden.
+	b := make([]byte, len(orig))
+	if err := dw.ReadFlash(context.Background(), 0, b); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, orig) {
+		t.Fatalf("breakpoints not hidden: %v != %v", b, orig)
+	}
+
+	// resuming from a breakpoint without changes doesn't touch the flash.
+	continueToHalt(t, dw)
+	checkPC(t, dw, 4)
+	if len(c.pageWrites) != 1 {
+		t.Fatalf("bad page rewrites: %v", c.pageWrites)
+	}
+}
+
+func TestBreakpointsRemove(t *testing.T) {
+	dw, c, orig := newBreakpointsTarget(t)
+	defer dw.Close()
+
+	if !dw.SetHwBreakpoint(0x7ffe) {
+		t.Fatal("hardware breakpoint not available")
+	}
+	for _, a := range []uint16{2, 4} {
+		if err := dw.SetSwBreakpoint(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	continueToHalt(t, dw)
+	checkPC(t, dw, 2)
+
+	if err := dw.ClearSwBreakpoint(4); err != nil {
+		t.Fatal(err)
+	}
+	continueToHalt(t, dw)
+	checkPC(t, dw, 2)
+	if len(c.pageWrites) != 2 {
+		t.Fatalf("bad page rewrites: %v", c.pageWrites)
+	}
+	if w := rawFlashWord(t, dw, 4); w != (uint16(orig[5])<<8)|uint16(orig[4]) {
+		t.Fatalf("instruction not restored: 0x%04x", w)
+	}
+
+	if err := dw.ClearSwBreakpoints(); err != nil {
+		t.Fatal(err)
+	}
+	if dw.HasSwBreakpoints() {
+		t.Fatal("breakpoints still in flash")
+	}
+	b := make([]byte, len(orig))
+	if err := dw.adapter.ReadFlash(0, b); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, orig) {
+		t.Fatalf("flash not restored: %v != %v", b, orig)
+	}
+}
+
+func TestBreakpointsHardwareReused(t *testing.T) {
+	dw, c, _ := newBreakpointsTarget(t)
+	defer dw.Close()
+
+	if err := dw.SetSwBreakpoint(2); err != nil {
+		t.Fatal(err)
+	}
+	continueToHalt(t, dw)
+	checkPC(t, dw, 2)
+
+	if err := dw.ClearSwBreakpoint(2); err != nil {
+		t.Fatal(err)
+	}
+	if err := dw.SetSwBreakpoint(4); err != nil {
+		t.Fatal(err)
+	}
+	continueToHalt(t, dw)
+	checkPC(t, dw, 4)
+
+	if !reflect.DeepEqual(c.hw, []uint16{2, 4}) {
+		t.Fatalf("bad hardware breakpoints: %v", c.hw)
+	}
+	if len(c.pageWrites) != 0 || dw.HasSwBreakpoints() {
+		t.Fatalf("flash rewritten: %v", c.pageWrites)
+	}
+}
+
+func TestBreakpointsFlashRewritten(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		f    func(dw *DebugWIRE, page []byte) error
+	}{
+		{"WriteFlashPage", func(dw *DebugWIRE, page []byte) error {
+			return dw.WriteFlashPage(context.Background(), 0, page)
+		}},
+		{"WriteFlash", func(dw *DebugWIRE, page []byte) error {
+			return dw.WriteFlash(context.Background(), 2, page[2:4])
+		}},
+		{"EraseFlashPage", func(dw *DebugWIRE, page []byte) error {
+			return dw.EraseFlashPage(context.Background(), 0)
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dw, _, orig := newBreakpointsTarget(t)
+			defer dw.Close()
+
+			if !dw.SetHwBreakpoint(0x7ffe) {
+				t.Fatal("hardware breakpoint not available")
+			}
+			if err := dw.SetSwBreakpoint(2); err != nil {
+				t.Fatal(err)
+			}
+			continueToHalt(t, dw)
+			checkPC(t, dw, 2)
+
+			// the new program is erased flash where the breakpoint was.
+			page := make([]byte, dw.MCU.FlashPageSize())
+			for i := range page {
+				page[i] = 0xff
+			}
+			copy(page, orig[:2])
+			copy(page[4:], orig[4:])
+			if err := tc.f(dw, page); err != nil {
+				t.Fatal(err)
+			}
+			b := make([]byte, 2)
+			if err := dw.ReadFlash(context.Background(), 2, b); err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(b, []byte{0xff, 0xff}) {
+				t.Fatalf("original instruction read over new flash: %v", b)
+			}
+
+			if err := dw.ClearSwBreakpoints(); err != nil {
+				t.Fatal(err)
+			}
+			if w := rawFlashWord(t, dw, 2); w != 0xffff {
+				t.Fatalf("original instruction restored over new flash: 0x%04x", w)
+			}
+		})
+	}
+}
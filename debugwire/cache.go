@@ -2,49 +2,51 @@ package debugwire
 
 import (
 	"fmt"
-	"os"
+
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 )
 
+// the target state is cached while the target is halted, if Cache is
+// enabled. Most operations clobber some registers and the program counter,
+// that are written back only once, before the target resumes. SRAM and flash
+// are read by pages. I/O registers are not cached, except for SP and SREG, as
+// reading them may have side effects. If Cache is disabled, the calls that
+// read the target and then step or resume it still cache its state, and
+// write it back before returning.
+
+const sramCachePageSize = 0x40
+
 type regRange struct {
 	start byte
 	count byte
 }
 
-type cached struct {
-	dw *DebugWIRE
+type cache struct {
+	registers       [32]byte
+	registersLoaded bool
+	dirty           uint32
 
-	pc        uint16
-	mask      uint32
-	registers [32]byte
-	ranges    []regRange
-}
+	pc       uint16
+	pcLoaded bool
+	pcDirty  bool
 
-func (dw *DebugWIRE) cache(regs ...byte) (*cached, error) {
-	if !dw.Cache {
-		return &cached{
-			dw: dw,
-		}, nil
-	}
+	sfr  map[uint16]byte
+	sram map[uint16][]byte
 
-	mask := uint32(0)
-	for _, reg := range regs {
-		if reg >= 32 {
-			return nil, fmt.Errorf("debugwire: cache: invalid register: %d", reg)
-		}
-		mask |= (1 << reg)
-	}
-
-	pc, err := dw.adapter.GetPC()
-	if err != nil {
-		return nil, err
-	}
+	// flash only changes on our own writes, so it survives resumes.
+	flash map[uint16][]byte
+}
 
-	rv := &cached{
-		dw:   dw,
-		pc:   pc,
-		mask: mask,
+func newCache() *cache {
+	return &cache{
+		sfr:   make(map[uint16]byte),
+		sram:  make(map[uint16][]byte),
+		flash: make(map[uint16][]byte),
 	}
+}
 
+func registerRanges(mask uint32) []regRange {
+	rv := []regRange{}
 	start := byte(0)
 	count := byte(0)
 	for i := byte(0); i < 32; i++ {
@@ -54,40 +56,303 @@ func (dw *DebugWIRE) cache(regs ...byte) (*cached, error) {
 			}
 			count++
 			if i == 31 {
-				rv.ranges = append(rv.ranges, regRange{start, count})
+				rv = append(rv, regRange{start, count})
 			}
 		} else if count > 0 {
-			rv.ranges = append(rv.ranges, regRange{start, count})
+			rv = append(rv, regRange{start, count})
 			count = 0
 			start = 0
 		}
 	}
+	return rv
+}
+
+func (dw *DebugWIRE) loadRegisters() error {
+	c := dw.cached
+	if c.registersLoaded {
+		return nil
+	}
+
+	// reading the registers moves the program counter.
+	if err := dw.loadPC(); err != nil {
+		return err
+	}
+	c.pcDirty = true
+	if err := dw.adapter.ReadRegisters(0, c.registers[:]); err != nil {
+		return err
+	}
+	c.registersLoaded = true
+	return nil
+}
+
+func (dw *DebugWIRE) loadPC() error {
+	c := dw.cached
+	if c.pcLoaded {
+		return nil
+	}
+	pc, err := dw.adapter.GetPC()
+	if err != nil {
+		return err
+	}
+	c.pc = pc
+	c.pcLoaded = true
+	return nil
+}
+
+// clobber saves the registers and the program counter that are about to be
+// modified by an operation, to be restored before the target resumes.
+func (dw *DebugWIRE) clobber(regs ...byte) error {
+	if !dw.Cache {
+		return nil
+	}
+
+	mask := uint32(0)
+	for _, reg := range regs {
+		if reg >= 32 {
+			return fmt.Errorf("debugwire: cache: invalid register: %d", reg)
+		}
+		mask |= (1 << reg)
+	}
+
+	if err := dw.loadRegisters(); err != nil {
+		return err
+	}
+	dw.cached.dirty |= mask
+	dw.cached.pcDirty = true
+	return nil
+}
 
-	for _, r := range rv.ranges {
-		if err := dw.adapter.ReadRegisters(r.start, rv.registers[r.start:r.start+r.count]); err != nil {
-			return nil, err
+// flushCache writes the registers and the program counter back to the target
+// and drops the cached state, before resuming the target or executing
+// instructions. The cached state is kept if writing it back fails.
+func (dw *DebugWIRE) flushCache() error {
+	c := dw.cached
+	for _, r := range registerRanges(c.dirty) {
+		if err := dw.adapter.WriteRegisters(r.start, c.registers[r.start:r.start+r.count]); err != nil {
+			return err
 		}
 	}
+	if c.pcDirty {
+		if err := dw.adapter.SetPC(c.pc); err != nil {
+			return err
+		}
+	}
+	dw.dropCache(false)
+	return nil
+}
+
+// withCache runs f with the cache enabled, if it isn't, so that the
+// registers and the program counter clobbered by f are written back before
+// returning.
+func (dw *DebugWIRE) withCache(f func() error) error {
+	if dw.Cache {
+		return f()
+	}
+
+	dw.Cache = true
+	err := f()
+	if ferr := dw.flushCache(); err == nil {
+		err = ferr
+	}
+	dw.Cache = false
+	return err
+}
 
-	return rv, nil
+func (dw *DebugWIRE) dropCache(flash bool) {
+	c := newCache()
+	if !flash {
+		c.flash = dw.cached.flash
+	}
+	dw.cached = c
 }
 
-func (c *cached) restore() {
-	if !c.dw.Cache {
-		return
+func (dw *DebugWIRE) setPC(pc uint16) error {
+	if !dw.Cache {
+		return dw.adapter.SetPC(pc)
 	}
+	dw.cached.pc = pc
+	dw.cached.pcLoaded = true
+	dw.cached.pcDirty = true
+	return nil
+}
 
-	rv := func() error {
-		for _, r := range c.ranges {
-			if err := c.dw.adapter.WriteRegisters(r.start, c.registers[r.start:r.start+r.count]); err != nil {
+func (dw *DebugWIRE) getPC() (uint16, error) {
+	if !dw.Cache {
+		return dw.adapter.GetPC()
+	}
+	if err := dw.loadPC(); err != nil {
+		return 0, err
+	}
+	return dw.cached.pc, nil
+}
+
+func (dw *DebugWIRE) writeRegisters(start byte, regs []byte) error {
+	if !dw.Cache {
+		return dw.adapter.WriteRegisters(start, regs)
+	}
+	if int(start)+len(regs) > 32 {
+		return fmt.Errorf("debugwire: cache: invalid registers: %d + %d", start, len(regs))
+	}
+	if err := dw.loadRegisters(); err != nil {
+		return err
+	}
+	for i := range regs {
+		dw.cached.dirty |= 1 << (int(start) + i)
+	}
+	copy(dw.cached.registers[start:], regs)
+	return nil
+}
+
+func (dw *DebugWIRE) readRegisters(start byte, regs []byte) error {
+	if !dw.Cache {
+		return dw.adapter.ReadRegisters(start, regs)
+	}
+	if int(start)+len(regs) > 32 {
+		return fmt.Errorf("debugwire: cache: invalid registers: %d + %d", start, len(regs))
+	}
+	if err := dw.loadRegisters(); err != nil {
+		return err
+	}
+	copy(regs, dw.cached.registers[start:])
+	return nil
+}
+
+// writeSRAM writes through the cache, as SRAM isn't clobbered by other
+// operations.
+func (dw *DebugWIRE) writeSRAM(start uint16, data []byte) error {
+	if err := dw.clobber(30, 31); err != nil {
+		return err
+	}
+	if err := dw.adapter.WriteSRAM(start, data); err != nil {
+		return err
+	}
+	if dw.Cache {
+		dw.updateCachedSRAM(start, data)
+	}
+	return nil
+}
+
+func (dw *DebugWIRE) readSRAM(start uint16, data []byte) error {
+	if dw.Cache {
+		return dw.readCachedSRAM(start, data)
+	}
+	return dw.adapter.ReadSRAM(start, data)
+}
+
+func (dw *DebugWIRE) cachedSFR(addr uint16) bool {
+	if dw.MCU.SP().Size() == 0 {
+		return false
+	}
+	sp := dw.MCU.SP().Mem16()
+	return addr == dw.MCU.SREG().Mem16() || addr == sp || (addr == sp+1 && dw.MCU.SP().Size() > 1)
+}
+
+func (dw *DebugWIRE) fetchSRAM(start uint16, data []byte) error {
+	if err := dw.clobber(30, 31); err != nil {
+		return err
+	}
+	return dw.adapter.ReadSRAM(start, data)
+}
+
+func (dw *DebugWIRE) readCachedSRAM(start uint16, data []byte) error {
+	c := dw.cached
+
+	sfr := true
+	for i := range data {
+		if !dw.cachedSFR(start + uint16(i)) {
+			sfr = false
+			break
+		}
+	}
+	if sfr {
+		hit := true
+		for i := range data {
+			v, ok := c.sfr[start+uint16(i)]
+			if !ok {
+				hit = false
+				break
+			}
+			data[i] = v
+		}
+		if hit {
+			return nil
+		}
+		if err := dw.fetchSRAM(start, data); err != nil {
+			return err
+		}
+		for i, v := range data {
+			c.sfr[start+uint16(i)] = v
+		}
+		return nil
+	}
+
+	sram := common.SRAMStart(dw.MCU)
+	if start < sram || uint32(start)+uint32(len(data)) > 0x10000 {
+		return dw.fetchSRAM(start, data)
+	}
+
+	// pages are aligned to the SRAM start, so they don't include I/O
+	// registers.
+	for i := 0; i < len(data); {
+		addr := start + uint16(i)
+		pstart := addr - (addr-sram)%sramCachePageSize
+		page, ok := c.sram[pstart]
+		if !ok {
+			page = make([]byte, sramCachePageSize)
+			if err := dw.fetchSRAM(pstart, page); err != nil {
 				return err
 			}
+			c.sram[pstart] = page
+		}
+		i += copy(data[i:], page[addr-pstart:])
+	}
+	return nil
+}
+
+func (dw *DebugWIRE) updateCachedSRAM(start uint16, data []byte) {
+	c := dw.cached
+	sram := common.SRAMStart(dw.MCU)
+	for i, v := range data {
+		addr := start + uint16(i)
+		if _, ok := c.sfr[addr]; ok {
+			c.sfr[addr] = v
+		}
+		if addr < sram {
+			continue
+		}
+		pstart := addr - (addr-sram)%sramCachePageSize
+		if page, ok := c.sram[pstart]; ok {
+			page[addr-pstart] = v
 		}
+	}
+}
+
+func (dw *DebugWIRE) readCachedFlash(start uint16, b []byte) error {
+	c := dw.cached
+	size := dw.MCU.FlashPageSize()
 
-		return c.dw.adapter.SetPC(c.pc)
-	}()
+	for i := 0; i < len(b); {
+		addr := start + uint16(i)
+		pstart := addr - addr%size
+		page, ok := c.flash[pstart]
+		if !ok {
+			page = make([]byte, size)
+			if err := dw.clobber(30, 31); err != nil {
+				return err
+			}
+			if err := dw.adapter.ReadFlash(pstart, page); err != nil {
+				return err
+			}
+			c.flash[pstart] = page
+		}
+		i += copy(b[i:], page[addr-pstart:])
+	}
+	return nil
+}
 
-	if rv != nil {
-		fmt.Fprintf(os.Stderr, "Error: debugwire: cache: %s\n", rv)
+func (dw *DebugWIRE) invalidateFlash(start uint16, size uint16) {
+	page := uint32(dw.MCU.FlashPageSize())
+	for a := uint32(start) - uint32(start)%page; a < uint32(start)+uint32(size); a += page {
+		delete(dw.cached.flash, uint16(a))
 	}
 }
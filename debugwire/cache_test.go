@@ -0,0 +1,245 @@
+package debugwire
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dwtk/dwtk/debugwire/adapters"
+)
+
+const cacheProgram = `
+	ldi r16, 0x5a
+	sts 0x100, r16
+	inc r17
+loop:
+	rjmp loop
+`
+
+func newCacheTarget(t *testing.T) (*DebugWIRE, *countingAdapter) {
+	t.Helper()
+
+	dw := newTestDebugWIRE(t, cacheProgram)
+	c := &countingAdapter{Adapter: dw.adapter}
+	dw.adapter = c
+	dw.Cache = true
+	return dw, c
+}
+
+func checkSRAM(t *testing.T, dw *DebugWIRE, addr uint16, exp byte) {
+	t.Helper()
+
+	b := make([]byte, 1)
+	if err := dw.ReadSRAM(addr, b); err != nil {
+		t.Fatal(err)
+	}
+	if b[0] != exp {
+		t.Fatalf("bad sram at 0x%04x: 0x%02x != 0x%02x", addr, b[0], exp)
+	}
+}
+
+func TestCacheWriteInvalidates(t *testing.T) {
+	dw, c := newCacheTarget(t)
+	defer dw.Close()
+
+	checkSRAM(t, dw, 0x120, 0)
+	flash := make([]byte, 4)
+	if err := dw.ReadFlash(context.Background(), 0x100, flash); err != nil {
+		t.Fatal(err)
+	}
+	reads := c.reads
+
+	// cached.
+	checkSRAM(t, dw, 0x121, 0)
+	if err := dw.ReadFlash(context.Background(), 0x100, flash); err != nil {
+		t.Fatal(err)
+	}
+	if c.reads != reads {
+		t.Fatalf("cached reads hit the target: %d", c.reads-reads)
+	}
+
+	if err := dw.WriteSRAM(0x120, []byte{0x12, 0x34}); err != nil {
+		t.Fatal(err)
+	}
+	checkSRAM(t, dw, 0x121, 0x34)
+
+	if err := dw.WriteFlash(context.Background(), 0x102, []byte{0x56, 0x78}); err != nil {
+		t.Fatal(err)
+	}
+	if err := dw.ReadFlash(context.Background(), 0x100, flash); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(flash, []byte{0xff, 0xff, 0x56, 0x78}) {
+		t.Fatalf("stale flash: %v", flash)
+	}
+
+	b := make([]byte, 2)
+	if err := dw.adapter.ReadSRAM(0x120, b); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, []byte{0x12, 0x34}) {
+		t.Fatalf("sram not written through: %v", b)
+	}
+}
+
+func TestCacheDroppedOnResume(t *testing.T) {
+	dw, _ := newCacheTarget(t)
+	defer dw.Close()
+
+	checkSRAM(t, dw, 0x100, 0)
+	checkReg(t, dw, 16, 0)
+
+	for i := 0; i < 2; i++ {
+		if err := dw.Step(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkPC(t, dw, 6)
+	checkSRAM(t, dw, 0x100, 0x5a)
+	checkReg(t, dw, 16, 0x5a)
+	checkReg(t, dw, 17, 0)
+
+	if err := dw.SetSwBreakpoint(8); err != nil {
+		t.Fatal(err)
+	}
+	continueToHalt(t, dw)
+	checkPC(t, dw, 8)
+	checkReg(t, dw, 17, 1)
+}
+
+func TestCacheMatchesTarget(t *testing.T) {
+	dw, c := newCacheTarget(t)
+	defer dw.Close()
+
+	if err := dw.SetSwBreakpoint(8); err != nil {
+		t.Fatal(err)
+	}
+	continueToHalt(t, dw)
+
+	// the registers clobbered by the flash reads are restored on resume,
+	// so they must be read first.
+	regs := make([]byte, 32)
+	if err := dw.ReadRegisters(0, regs); err != nil {
+		t.Fatal(err)
+	}
+	pc, err := dw.GetPC()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sp, err := dw.GetSP()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sreg, err := dw.GetSREG()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sram := make([]byte, 0x80)
+	if err := dw.ReadSRAM(0xf0, sram); err != nil {
+		t.Fatal(err)
+	}
+	flash := make([]byte, 0x10)
+	if err := dw.ReadFlash(context.Background(), 0, flash); err != nil {
+		t.Fatal(err)
+	}
+
+	a := c.Adapter
+	tregs := make([]byte, 32)
+	if err := a.ReadRegisters(0, tregs); err != nil {
+		t.Fatal(err)
+	}
+	tpc, err := a.GetPC()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tsfr := make([]byte, 3)
+	if err := a.ReadSRAM(dw.MCU.SP().Mem16(), tsfr); err != nil {
+		t.Fatal(err)
+	}
+	tsram := make([]byte, len(sram))
+	if err := a.ReadSRAM(0xf0, tsram); err != nil {
+		t.Fatal(err)
+	}
+	tflash := make([]byte, len(flash))
+	if err := a.ReadFlash(0, tflash); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(regs, tregs) {
+		t.Fatalf("bad registers: %v != %v", regs, tregs)
+	}
+	if pc != tpc {
+		t.Fatalf("bad pc: 0x%04x != 0x%04x", pc, tpc)
+	}
+	if sp != (uint16(tsfr[1])<<8)|uint16(tsfr[0]) || sreg != tsfr[2] {
+		t.Fatalf("bad sp or sreg: 0x%04x 0x%02x != %v", sp, sreg, tsfr)
+	}
+	if !bytes.Equal(sram, tsram) {
+		t.Fatalf("bad sram: %v != %v", sram, tsram)
+	}
+	if !bytes.Equal(flash, tflash) {
+		t.Fatalf("bad flash: %v != %v", flash, tflash)
+	}
+}
+
+func TestCacheSRAMStart(t *testing.T) {
+	dw, err := New(&adapters.Options{Sim: "attiny85"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dw.Close()
+	c := &countingAdapter{Adapter: dw.adapter}
+	dw.adapter = c
+	dw.Cache = true
+
+	// SRAM starts right after the I/O registers, with no extended I/O.
+	checkSRAM(t, dw, 0x60, 0)
+	reads := c.reads
+	checkSRAM(t, dw, 0x9f, 0)
+	if c.reads != reads {
+		t.Fatalf("SRAM not cached: %d", c.reads-reads)
+	}
+
+	checkSRAM(t, dw, 0x38, 0)
+	reads = c.reads
+	checkSRAM(t, dw, 0x38, 0)
+	if c.reads == reads {
+		t.Fatal("I/O register cached")
+	}
+}
+
+// failingAdapter fails to write the program counter while fail is set.
+type failingAdapter struct {
+	adapters.Adapter
+	fail bool
+}
+
+func (f *failingAdapter) SetPC(pc uint16) error {
+	if f.fail {
+		return errors.New("failed")
+	}
+	return f.Adapter.SetPC(pc)
+}
+
+func TestCacheFlushFailure(t *testing.T) {
+	dw := newClobberingTarget(t, cacheProgram)
+	defer dw.Close()
+	f := &failingAdapter{Adapter: dw.adapter}
+	dw.adapter = f
+	dw.Cache = true
+
+	checkSRAM(t, dw, 0x120, 0)
+	f.fail = true
+	if err := dw.Step(); err == nil {
+		t.Fatal("step succeeded")
+	}
+
+	// the clobbered state is still written back.
+	f.fail = false
+	if err := dw.Step(); err != nil {
+		t.Fatal(err)
+	}
+	checkPC(t, dw, 2)
+	checkReg(t, dw, 30, 0)
+}
@@ -2,24 +2,39 @@ package debugwire
 
 import (
 	"context"
+	"fmt"
+	"sync"
 
-	"github.com/dwtk/devices"
 	"github.com/dwtk/dwtk/debugwire/adapters"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 )
 
+// DebugWIRE is safe for concurrent use, as the adapter is only accessed by one
+// method at a time. Methods call each other through unexported variants that
+// don't lock. Wait, RunTo, StepOver and StepOut are the exceptions that hold
+// the adapter for long, so SendBreak interrupts them instead of waiting for
+// them.
 type DebugWIRE struct {
-	MCU    *devices.MCU
-	Timers bool
-	Cache  bool
-
-	adapter         adapters.Adapter
-	hwBreakpoint    uint16
-	hwBreakpointSet bool
-	swBreakpoints   map[uint16]uint16
+	MCU      common.MCU
+	Timers   bool
+	Cache    bool
+	AutoMode bool
+
+	adapter     adapters.Adapter
+	adapterID   string
+	breakpoints *breakpoints
+	cached      *cache
+	journal     bool
+	mutex       *sync.Mutex
+
+	// guards waitCancel and breaking, without waiting for the adapter.
+	waitMutex  *sync.Mutex
+	waitCancel context.CancelFunc
+	breaking   bool
 }
 
-func New(dwtkIce string, serialPort string, baudrate uint32) (*DebugWIRE, error) {
-	a, err := adapters.New(dwtkIce, serialPort, baudrate)
+func New(opts *adapters.Options) (*DebugWIRE, error) {
+	a, err := adapters.New(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -27,9 +42,14 @@ func New(dwtkIce string, serialPort string, baudrate uint32) (*DebugWIRE, error)
 	rv := &DebugWIRE{
 		Timers: false,
 
-		adapter:         a,
-		hwBreakpointSet: false,
-		swBreakpoints:   make(map[uint16]uint16, 1),
+		adapter:     a,
+		breakpoints: newBreakpoints(),
+		cached:      newCache(),
+		mutex:       &sync.Mutex{},
+		waitMutex:   &sync.Mutex{},
+
+		// simulated flash doesn't survive the session.
+		journal: opts.Sim == "" && opts.Replay == "",
 	}
 
 	sign, err := a.ReadSignature()
@@ -38,7 +58,7 @@ func New(dwtkIce string, serialPort string, baudrate uint32) (*DebugWIRE, error)
 		return nil, err
 	}
 
-	rv.MCU, err = devices.GetBySignature(sign)
+	rv.MCU, err = adapters.GetMCUBySignature(sign)
 	if err != nil {
 		rv.Close()
 		return nil, err
@@ -49,129 +69,336 @@ func New(dwtkIce string, serialPort string, baudrate uint32) (*DebugWIRE, error)
 }
 
 func (dw *DebugWIRE) Close() error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
 	return dw.adapter.Close()
 }
 
 func (dw *DebugWIRE) Info() string {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
 	return dw.adapter.Info()
 }
 
+func (dw *DebugWIRE) Capabilities() common.Capabilities {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	return dw.adapter.Capabilities()
+}
+
 func (dw *DebugWIRE) Enable() error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
 	return dw.adapter.Enable()
 }
 
 func (dw *DebugWIRE) Disable() error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	dw.dropCache(true)
 	return dw.adapter.Disable()
 }
 
 func (dw *DebugWIRE) Reset() error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	dw.dropCache(false)
 	return dw.adapter.Reset()
 }
 
 func (dw *DebugWIRE) ReadSignature() (uint16, error) {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
 	return dw.adapter.ReadSignature()
 }
 
 func (dw *DebugWIRE) ChipErase() error {
-	return dw.adapter.ChipErase()
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	dw.dropCache(true)
+	if err := dw.adapter.ChipErase(); err != nil {
+		return err
+	}
+	return dw.forgetBreakpoints(0, uint32(dw.MCU.FlashSize()))
 }
 
+// SendBreak halts the target. A Wait running in another goroutine is
+// interrupted, and returns without signaling, before the break is sent.
 func (dw *DebugWIRE) SendBreak() error {
+	dw.waitMutex.Lock()
+	dw.breaking = true
+	if dw.waitCancel != nil {
+		dw.waitCancel()
+	}
+	dw.waitMutex.Unlock()
+
+	defer func() {
+		dw.waitMutex.Lock()
+		dw.breaking = false
+		dw.waitMutex.Unlock()
+	}()
+
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
 	return dw.adapter.SendBreak()
 }
 
 func (dw *DebugWIRE) RecvBreak() error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
 	return dw.adapter.RecvBreak()
 }
 
 func (dw *DebugWIRE) Go() error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	if err := dw.flushCache(); err != nil {
+		return err
+	}
 	return dw.adapter.Go()
 }
 
 func (dw *DebugWIRE) ResetAndGo() error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	dw.dropCache(false)
 	return dw.adapter.ResetAndGo()
 }
 
+// Step executes the original instruction if the target is halted on a
+// software breakpoint, without touching the flash. Adapters that can't
+// execute instructions get the BREAK instruction removed from flash instead.
 func (dw *DebugWIRE) Step() error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	return dw.withCache(dw.step)
+}
+
+func (dw *DebugWIRE) step() error {
+	pc, op, ok, err := dw.haltedOnBreakpoint()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if err := dw.flushCache(); err != nil {
+			return err
+		}
+		return dw.adapter.Step()
+	}
+
+	if dw.adapter.Capabilities().Has(common.CapDebugWIRE) {
+		if err := dw.stepBreakpoint(pc, op); err != nil {
+			return err
+		}
+		return dw.flushCache()
+	}
+	if _, _, err := dw.commitBreakpoints(&pc); err != nil {
+		return err
+	}
+	if err := dw.flushCache(); err != nil {
+		return err
+	}
 	return dw.adapter.Step()
 }
 
+// Continue commits the breakpoints before resuming the target, stepping out
+// of a software breakpoint first.
 func (dw *DebugWIRE) Continue() error {
-	return dw.adapter.Continue(dw.hwBreakpoint, dw.hwBreakpointSet, dw.Timers)
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	return dw.withCache(dw.continueTarget)
+}
+
+func (dw *DebugWIRE) continueTarget() error {
+	if _, _, ok, err := dw.haltedOnBreakpoint(); err != nil {
+		return err
+	} else if ok {
+		if err := dw.step(); err != nil {
+			return err
+		}
+	}
+
+	hw, hwSet, err := dw.commitBreakpoints(nil)
+	if err != nil {
+		return err
+	}
+	if err := dw.flushCache(); err != nil {
+		return err
+	}
+	return dw.adapter.Continue(hw, hwSet, dw.Timers)
+}
+
+// haltedOnBreakpoint returns the program counter and the original
+// instruction if the target is halted on a software breakpoint.
+func (dw *DebugWIRE) haltedOnBreakpoint() (uint16, uint16, bool, error) {
+	if len(dw.breakpoints.patched) == 0 {
+		return 0, 0, false, nil
+	}
+	pc, err := dw.getPC()
+	if err != nil {
+		return 0, 0, false, err
+	}
+	op, ok := dw.breakpoints.patched[pc]
+	return pc, op, ok, nil
 }
 
+// Wait sends to c when the target halts. The adapter is locked until the
+// target halts, ctx is done or SendBreak is called, so other calls wait for
+// it. Wait returns nil without sending to c if interrupted by SendBreak.
 func (dw *DebugWIRE) Wait(ctx context.Context, c chan bool) error {
-	return dw.adapter.Wait(ctx, c)
+	wctx, done := dw.interruptible(ctx)
+	defer done()
+	if wctx.Err() != nil {
+		return nil
+	}
+
+	h := make(chan bool, 1)
+	if err := func() error {
+		dw.mutex.Lock()
+		defer dw.mutex.Unlock()
+		return dw.adapter.Wait(wctx, h)
+	}(); err != nil {
+		return err
+	}
+
+	select {
+	case <-h:
+	default:
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+	case c <- true:
+	}
+	return nil
 }
 
+// interruptible returns a context derived from ctx that is canceled by
+// SendBreak, for the calls that hold the adapter while the target runs. It
+// is canceled already if SendBreak is running. The returned function must be
+// called before returning.
+func (dw *DebugWIRE) interruptible(ctx context.Context) (context.Context, func()) {
+	wctx, cancel := context.WithCancel(ctx)
+
+	dw.waitMutex.Lock()
+	if dw.breaking {
+		cancel()
+	} else {
+		dw.waitCancel = cancel
+	}
+	dw.waitMutex.Unlock()
+
+	return wctx, func() {
+		dw.waitMutex.Lock()
+		dw.waitCancel = nil
+		dw.waitMutex.Unlock()
+		cancel()
+	}
+}
+
+// WriteInstruction executes inst with the target registers, so the cached
+// state is written back first and dropped.
 func (dw *DebugWIRE) WriteInstruction(inst uint16) error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	return dw.writeInstruction(inst)
+}
+
+func (dw *DebugWIRE) writeInstruction(inst uint16) error {
+	if err := dw.flushCache(); err != nil {
+		return err
+	}
 	return dw.adapter.WriteInstruction(inst)
 }
 
 func (dw *DebugWIRE) SetPC(pc uint16) error {
-	return dw.adapter.SetPC(pc)
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	return dw.setPC(pc)
 }
 
 func (dw *DebugWIRE) GetPC() (uint16, error) {
-	return dw.adapter.GetPC()
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	return dw.getPC()
 }
 
 func (dw *DebugWIRE) WriteRegisters(start byte, regs []byte) error {
-	cache, err := dw.cache()
-	if err != nil {
-		return err
-	}
-	defer cache.restore()
-	return dw.adapter.WriteRegisters(start, regs)
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	return dw.writeRegisters(start, regs)
 }
 
 func (dw *DebugWIRE) ReadRegisters(start byte, regs []byte) error {
-	cache, err := dw.cache()
-	if err != nil {
-		return err
-	}
-	defer cache.restore()
-	return dw.adapter.ReadRegisters(start, regs)
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	return dw.readRegisters(start, regs)
 }
 
 func (dw *DebugWIRE) WriteSRAM(start uint16, data []byte) error {
-	cache, err := dw.cache(30, 31)
-	if err != nil {
-		return err
-	}
-	defer cache.restore()
-	return dw.adapter.WriteSRAM(start, data)
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	return dw.writeSRAM(start, data)
 }
 
 func (dw *DebugWIRE) ReadSRAM(start uint16, data []byte) error {
-	cache, err := dw.cache(30, 31)
-	if err != nil {
-		return err
-	}
-	defer cache.restore()
-	return dw.adapter.ReadSRAM(start, data)
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	return dw.readSRAM(start, data)
 }
 
+// ReadFuses writes back the registers that reading the fuses over debugWIRE
+// clobbers, even if the cache is disabled.
 func (dw *DebugWIRE) ReadFuses() ([]byte, error) {
-	cache, err := dw.cache(28, 29, 30, 31)
-	if err != nil {
-		return nil, err
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+
+	if !dw.adapter.Capabilities().Has(common.CapDebugWIRE) {
+		return dw.adapter.ReadFuses()
 	}
-	defer cache.restore()
-	return dw.adapter.ReadFuses()
+
+	var rv []byte
+	err := dw.withCache(func() error {
+		if err := dw.clobber(28, 29, 30, 31); err != nil {
+			return err
+		}
+		var err error
+		rv, err = dw.adapter.ReadFuses()
+		return err
+	})
+	return rv, err
 }
+
 func (dw *DebugWIRE) WriteLFuse(data byte) error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
 	return dw.adapter.WriteLFuse(data)
 }
 
 func (dw *DebugWIRE) WriteHFuse(data byte) error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
 	return dw.adapter.WriteHFuse(data)
 }
 
 func (dw *DebugWIRE) WriteEFuse(data byte) error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
 	return dw.adapter.WriteEFuse(data)
 }
 
 func (dw *DebugWIRE) WriteLock(data byte) error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
 	return dw.adapter.WriteLock(data)
 }
+
+// interrupted returns an error if ctx is done, for long operations to stop
+// between pages.
+func interrupted(ctx context.Context, mem string, addr uint16) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("debugwire: %s: interrupted at 0x%04x: %w", mem, addr, err)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package debugwire
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dwtk/dwtk/debugwire/adapters"
+	"github.com/dwtk/dwtk/internal/adaptertest"
+)
+
+// newTestDebugWIRE returns a simulated target running src from the first
+// flash page, halted at address 0.
+func newTestDebugWIRE(t *testing.T, src string) *DebugWIRE {
+	t.Helper()
+
+	dw, err := New(&adapters.Options{Sim: "atmega328p"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	adaptertest.WriteProgram(t, dw.adapter, src)
+	if err := dw.SetPC(0); err != nil {
+		t.Fatal(err)
+	}
+	return dw
+}
+
+// clobberingAdapter overwrites the program counter and Z after each memory
+// access, as debugWIRE adapters do, unlike the simulator.
+type clobberingAdapter struct {
+	adapters.Adapter
+}
+
+const (
+	clobberedPC = 0x0100
+	clobberedZ  = 0xa5
+)
+
+func (c *clobberingAdapter) clobber(err error) error {
+	if err != nil {
+		return err
+	}
+	if err := c.Adapter.WriteRegisters(30, []byte{clobberedZ, clobberedZ}); err != nil {
+		return err
+	}
+	return c.Adapter.SetPC(clobberedPC)
+}
+
+func (c *clobberingAdapter) ReadSRAM(start uint16, data []byte) error {
+	return c.clobber(c.Adapter.ReadSRAM(start, data))
+}
+
+func (c *clobberingAdapter) WriteSRAM(start uint16, data []byte) error {
+	return c.clobber(c.Adapter.WriteSRAM(start, data))
+}
+
+func (c *clobberingAdapter) ReadFlash(start uint16, data []byte) error {
+	return c.clobber(c.Adapter.ReadFlash(start, data))
+}
+
+func (c *clobberingAdapter) WriteFlashPage(start uint16, data []byte) error {
+	return c.clobber(c.Adapter.WriteFlashPage(start, data))
+}
+
+func (c *clobberingAdapter) ReadFuses() ([]byte, error) {
+	rv, err := c.Adapter.ReadFuses()
+	return rv, c.clobber(err)
+}
+
+// newClobberingTarget returns a target like newTestDebugWIRE, whose memory
+// accesses clobber the program counter and Z.
+func newClobberingTarget(t *testing.T, src string) *DebugWIRE {
+	t.Helper()
+
+	dw := newTestDebugWIRE(t, src)
+	dw.adapter = &clobberingAdapter{Adapter: dw.adapter}
+	return dw
+}
+
+// continueToHalt resumes the target, and waits up to 5 seconds for it to
+// halt.
+func continueToHalt(t *testing.T, dw *DebugWIRE) {
+	t.Helper()
+
+	if err := dw.Continue(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	c := make(chan bool, 1)
+	if err := dw.Wait(ctx, c); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-c:
+	default:
+		t.Fatal("target did not halt")
+	}
+	if err := dw.RecvBreak(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func checkPC(t *testing.T, dw *DebugWIRE, exp uint16) {
+	t.Helper()
+
+	pc, err := dw.GetPC()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pc != exp {
+		t.Fatalf("bad pc: 0x%04x != 0x%04x", pc, exp)
+	}
+}
+
+func checkSP(t *testing.T, dw *DebugWIRE, exp uint16) {
+	t.Helper()
+
+	sp, err := dw.GetSP()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sp != exp {
+		t.Fatalf("bad sp: 0x%04x != 0x%04x", sp, exp)
+	}
+}
+
+func checkReg(t *testing.T, dw *DebugWIRE, reg byte, exp byte) {
+	t.Helper()
+
+	r := make([]byte, 1)
+	if err := dw.ReadRegisters(reg, r); err != nil {
+		t.Fatal(err)
+	}
+	if r[0] != exp {
+		t.Fatalf("bad r%d: 0x%02x != 0x%02x", reg, r[0], exp)
+	}
+}
+
+func TestReadFusesKeepsState(t *testing.T) {
+	dw := newClobberingTarget(t, `
+	loop:
+		rjmp loop
+	`)
+	defer dw.Close()
+
+	if _, err := dw.ReadFuses(); err != nil {
+		t.Fatal(err)
+	}
+	checkPC(t, dw, 0)
+	checkReg(t, dw, 30, 0)
+	checkReg(t, dw, 31, 0)
+}
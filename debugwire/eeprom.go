@@ -1,38 +1,80 @@
 package debugwire
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 )
 
-func (dw *DebugWIRE) readEEPROM(start uint16, b []byte) error {
+var (
+	eearFromZ8  = common.NewSnippet("out EEARL, r30")
+	eearFromZ16 = common.NewSnippet(`
+		out EEARL, r30
+		out EEARH, r31
+	`)
+	eepromRead = common.NewSnippet(`
+		out EECR, r29   ; EERE
+		adiw r30, 1
+		in r0, EEDR
+	`)
+	eepromWrite = common.NewSnippet(`
+		out EEDR, r0
+		adiw r30, 1
+		out EECR, r28   ; EEMPE
+		out EECR, r29   ; EEPE
+	`)
+	incZ = common.NewSnippet("adiw r30, 1")
+)
+
+// eearFromZ returns the instructions to set EEAR from Z.
+func (dw *DebugWIRE) eearFromZ() *common.Snippet {
+	if dw.MCU.EEAR().Size() > 1 {
+		return eearFromZ16
+	}
+	return eearFromZ8
+}
+
+// eepromChunkSize is how many bytes are read or written by adapters that
+// access EEPROM themselves, between checks for interruption.
+const eepromChunkSize = 0x20
+
+// eepromChunks calls f for chunks of b, stopping before the next chunk if ctx
+// is done.
+func eepromChunks(ctx context.Context, start uint16, b []byte, f func(start uint16, b []byte) error) error {
+	for i := 0; i < len(b); i += eepromChunkSize {
+		if err := interrupted(ctx, "eeprom", start+uint16(i)); err != nil {
+			return err
+		}
+		end := i + eepromChunkSize
+		if end > len(b) {
+			end = len(b)
+		}
+		if err := f(start+uint16(i), b[i:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (dw *DebugWIRE) readEEPROM(ctx context.Context, start uint16, b []byte) error {
 	c := []byte{
 		avr.EERE,
 		byte(start), byte(start >> 8),
 	}
 	if err := dw.adapter.WriteRegisters(29, c); err != nil {
-		return nil
+		return err
 	}
 
+	eear := dw.eearFromZ()
 	d := make([]byte, 1)
 	for i := 0; i < len(b); i++ {
-		if err := dw.WriteInstruction(avr.OUT(dw.MCU.EEAR().Io8(), 30)); err != nil {
-			return err
-		}
-		if dw.MCU.EEAR().Size() > 1 {
-			if err := dw.WriteInstruction(avr.OUT(dw.MCU.EEAR().Io8()+1, 31)); err != nil {
-				return err
-			}
-		}
-		if err := dw.WriteInstruction(avr.OUT(dw.MCU.EECR().Io8(), 29)); err != nil {
+		if err := interrupted(ctx, "eeprom", start+uint16(i)); err != nil {
 			return err
 		}
-		if err := dw.WriteInstruction(avr.ADIW(30, 1)); err != nil {
-			return err
-		}
-		if err := dw.WriteInstruction(avr.IN(dw.MCU.EEDR().Io8(), 0)); err != nil {
+		if err := common.Execute(dw.adapter, eear, eepromRead); err != nil {
 			return err
 		}
 		if err := dw.adapter.ReadRegisters(0, d); err != nil {
@@ -44,7 +86,12 @@ func (dw *DebugWIRE) readEEPROM(start uint16, b []byte) error {
 	return nil
 }
 
-func (dw *DebugWIRE) WriteEEPROM(start uint16, b []byte) error {
+// WriteEEPROM writes b to EEPROM, skipping bytes that are already set. If ctx
+// is done, it stops before the next byte.
+func (dw *DebugWIRE) WriteEEPROM(ctx context.Context, start uint16, b []byte) error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+
 	if start+uint16(len(b)) > dw.MCU.EEPROMSize() {
 		return fmt.Errorf("debugwire: eeprom: writing out of eeprom space: 0x%04x + 0x%04x > 0x%04x",
 			start,
@@ -53,14 +100,16 @@ func (dw *DebugWIRE) WriteEEPROM(start uint16, b []byte) error {
 		)
 	}
 
-	cache, err := dw.cache(0, 1, 28, 29, 30, 31)
-	if err != nil {
+	if !dw.adapter.Capabilities().Has(common.CapDebugWIRE) {
+		return eepromChunks(ctx, start, b, dw.adapter.WriteEEPROM)
+	}
+
+	if err := dw.clobber(0, 1, 28, 29, 30, 31); err != nil {
 		return err
 	}
-	defer cache.restore()
 
 	w := make([]byte, len(b))
-	if err := dw.readEEPROM(start, w); err != nil {
+	if err := dw.readEEPROM(ctx, start, w); err != nil {
 		return err
 	}
 
@@ -70,41 +119,28 @@ func (dw *DebugWIRE) WriteEEPROM(start uint16, b []byte) error {
 		byte(start), byte(start >> 8),
 	}
 	if err := dw.adapter.WriteRegisters(28, c); err != nil {
-		return nil
+		return err
 	}
 
+	eear := dw.eearFromZ()
 	for i := 0; i < len(b); i++ {
+		if err := interrupted(ctx, "eeprom", start+uint16(i)); err != nil {
+			return err
+		}
 		if b[i] == w[i] { // do not write unless needed
-			if err := dw.WriteInstruction(avr.ADIW(30, 1)); err != nil {
+			if err := common.Execute(dw.adapter, incZ); err != nil {
 				return err
 			}
 			continue
 		}
 
-		if err := dw.WriteInstruction(avr.OUT(dw.MCU.EEAR().Io8(), 30)); err != nil {
-			return err
-		}
-		if dw.MCU.EEAR().Size() > 1 {
-			if err := dw.WriteInstruction(avr.OUT(dw.MCU.EEAR().Io8()+1, 31)); err != nil {
-				return err
-			}
-		}
 		if err := dw.adapter.WriteRegisters(0, []byte{b[i]}); err != nil {
-			return nil
-		}
-		if err := dw.WriteInstruction(avr.OUT(dw.MCU.EEDR().Io8(), 0)); err != nil {
-			return err
-		}
-		if err := dw.WriteInstruction(avr.ADIW(30, 1)); err != nil {
 			return err
 		}
-		if err := dw.WriteInstruction(avr.OUT(dw.MCU.EECR().Io8(), 28)); err != nil {
+		if err := common.Execute(dw.adapter, eear, eepromWrite); err != nil {
 			return err
 		}
-		if err := dw.WriteInstruction(avr.OUT(dw.MCU.EECR().Io8(), 29)); err != nil {
-			return err
-		}
-		if err := dw.SendBreak(); err != nil {
+		if err := dw.adapter.SendBreak(); err != nil {
 			return err
 		}
 
@@ -114,7 +150,11 @@ func (dw *DebugWIRE) WriteEEPROM(start uint16, b []byte) error {
 	return nil
 }
 
-func (dw *DebugWIRE) ReadEEPROM(start uint16, b []byte) error {
+// ReadEEPROM reads EEPROM. If ctx is done, it stops before the next byte.
+func (dw *DebugWIRE) ReadEEPROM(ctx context.Context, start uint16, b []byte) error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+
 	if start+uint16(len(b)) > dw.MCU.EEPROMSize() {
 		return fmt.Errorf("debugwire: eeprom: reading out of eeprom space: 0x%04x + 0x%04x > 0x%04x",
 			start,
@@ -123,11 +163,13 @@ func (dw *DebugWIRE) ReadEEPROM(start uint16, b []byte) error {
 		)
 	}
 
-	cache, err := dw.cache(0, 1, 29, 30, 31)
-	if err != nil {
+	if !dw.adapter.Capabilities().Has(common.CapDebugWIRE) {
+		return eepromChunks(ctx, start, b, dw.adapter.ReadEEPROM)
+	}
+
+	if err := dw.clobber(0, 1, 29, 30, 31); err != nil {
 		return err
 	}
-	defer cache.restore()
 
-	return dw.readEEPROM(start, b)
+	return dw.readEEPROM(ctx, start, b)
 }
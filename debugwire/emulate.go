@@ -0,0 +1,265 @@
+package debugwire
+
+import (
+	"context"
+
+	"github.com/dwtk/dwtk/avr"
+)
+
+// the instruction replaced by a software breakpoint can't be executed from
+// flash without a page rewrite. Most instructions are executed in place with
+// WriteInstruction instead, that doesn't change the program counter, and the
+// ones that depend on it (two-word, branch, call, return and skip
+// instructions) are emulated.
+
+// flashWord returns the instruction at addr, as it was before any software
+// breakpoint was written.
+func (dw *DebugWIRE) flashWord(addr uint16) (uint16, error) {
+	if inst, ok := dw.breakpoints.patched[addr]; ok {
+		return inst, nil
+	}
+	b := make([]byte, 2)
+	if err := dw.readFlash(context.Background(), addr, b); err != nil {
+		return 0, err
+	}
+	return (uint16(b[1]) << 8) | uint16(b[0]), nil
+}
+
+func (dw *DebugWIRE) readReg(reg byte) (byte, error) {
+	b := make([]byte, 1)
+	if err := dw.readRegisters(reg, b); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (dw *DebugWIRE) readRegPair(reg byte) (uint16, error) {
+	b := make([]byte, 2)
+	if err := dw.readRegisters(reg, b); err != nil {
+		return 0, err
+	}
+	return (uint16(b[1]) << 8) | uint16(b[0]), nil
+}
+
+// readData and writeData access the data space, where the registers are
+// mapped to the first 32 bytes.
+func (dw *DebugWIRE) readData(addr uint16) (byte, error) {
+	if addr < 32 {
+		return dw.readReg(byte(addr))
+	}
+	b := make([]byte, 1)
+	if err := dw.readSRAM(addr, b); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (dw *DebugWIRE) writeData(addr uint16, v byte) error {
+	if addr < 32 {
+		return dw.writeRegisters(byte(addr), []byte{v})
+	}
+	return dw.writeSRAM(addr, []byte{v})
+}
+
+// pushPC pushes a return address (byte address) to the stack, the same way
+// CALL does.
+func (dw *DebugWIRE) pushPC(pc uint16) error {
+	sp, err := dw.getSP()
+	if err != nil {
+		return err
+	}
+	w := pc / 2
+	if err := dw.writeSRAM(sp-1, []byte{byte(w >> 8), byte(w)}); err != nil {
+		return err
+	}
+	return dw.setSP(sp - 2)
+}
+
+// returnAddress returns the return address (byte address) at the top of the
+// stack.
+func (dw *DebugWIRE) returnAddress(sp uint16) (uint16, error) {
+	b := make([]byte, 2)
+	if err := dw.readSRAM(sp+1, b); err != nil {
+		return 0, err
+	}
+	return ((uint16(b[0]) << 8) | uint16(b[1])) * 2, nil
+}
+
+func (dw *DebugWIRE) popPC() (uint16, error) {
+	sp, err := dw.getSP()
+	if err != nil {
+		return 0, err
+	}
+	pc, err := dw.returnAddress(sp)
+	if err != nil {
+		return 0, err
+	}
+	return pc, dw.setSP(sp + 2)
+}
+
+// skip returns the address of the instruction after the one following pc.
+func (dw *DebugWIRE) skip(pc uint16) (uint16, error) {
+	next, err := dw.flashWord(pc + 2)
+	if err != nil {
+		return 0, err
+	}
+	if avr.IsTwoWord(next) {
+		return pc + 6, nil
+	}
+	return pc + 4, nil
+}
+
+// emulate executes the instruction op, located at pc, and returns the
+// address of the next instruction. Instructions that don't depend on the
+// program counter are not emulated, and ok is false for them.
+func (dw *DebugWIRE) emulate(pc uint16, op uint16) (next uint16, ok bool, err error) {
+	d := byte((op >> 4) & 0x1f)
+	b := byte(op & 0x07)
+	rel12 := uint16(int16(op<<4)>>4) * 2
+	rel7 := uint16(int16(op<<6)>>9) * 2
+
+	switch {
+	case op&0xf000 == 0xc000: // RJMP
+		return pc + 2 + rel12, true, nil
+
+	case op&0xf000 == 0xd000: // RCALL
+		if err := dw.pushPC(pc + 2); err != nil {
+			return 0, true, err
+		}
+		return pc + 2 + rel12, true, nil
+
+	case op&0xfe0e == 0x940c, op&0xfe0e == 0x940e: // JMP, CALL
+		k, err := dw.flashWord(pc + 2)
+		if err != nil {
+			return 0, true, err
+		}
+		if op&0x0002 != 0 {
+			if err := dw.pushPC(pc + 4); err != nil {
+				return 0, true, err
+			}
+		}
+		return k * 2, true, nil
+
+	case op == 0x9409, op == 0x9419, op == 0x9509, op == 0x9519: // IJMP, EIJMP, ICALL, EICALL
+		z, err := dw.readRegPair(30)
+		if err != nil {
+			return 0, true, err
+		}
+		if op&0x0100 != 0 {
+			if err := dw.pushPC(pc + 2); err != nil {
+				return 0, true, err
+			}
+		}
+		return z * 2, true, nil
+
+	case op == 0x9508, op == 0x9518: // RET, RETI
+		next, err := dw.popPC()
+		if err != nil {
+			return 0, true, err
+		}
+		if op == 0x9518 {
+			sreg, err := dw.getSREG()
+			if err != nil {
+				return 0, true, err
+			}
+			if err := dw.setSREG(sreg | sregI); err != nil {
+				return 0, true, err
+			}
+		}
+		return next, true, nil
+
+	case op&0xf800 == 0xf000: // BRBS, BRBC
+		sreg, err := dw.getSREG()
+		if err != nil {
+			return 0, true, err
+		}
+		if (sreg&(1<<b) != 0) == (op&0x0400 == 0) {
+			return pc + 2 + rel7, true, nil
+		}
+		return pc + 2, true, nil
+
+	case op&0xfc00 == 0x1000: // CPSE
+		r := byte((op & 0x0f) | ((op >> 5) & 0x10))
+		rd, err := dw.readReg(d)
+		if err != nil {
+			return 0, true, err
+		}
+		rr, err := dw.readReg(r)
+		if err != nil {
+			return 0, true, err
+		}
+		if rd == rr {
+			next, err := dw.skip(pc)
+			return next, true, err
+		}
+		return pc + 2, true, nil
+
+	case op&0xfc08 == 0xfc00: // SBRC, SBRS
+		rd, err := dw.readReg(d)
+		if err != nil {
+			return 0, true, err
+		}
+		if (rd&(1<<b) != 0) == (op&0x0200 != 0) {
+			next, err := dw.skip(pc)
+			return next, true, err
+		}
+		return pc + 2, true, nil
+
+	case op&0xfd00 == 0x9900: // SBIC, SBIS
+		v, err := dw.readData(0x20 + ((op >> 3) & 0x1f))
+		if err != nil {
+			return 0, true, err
+		}
+		if (v&(1<<b) != 0) == (op&0x0200 != 0) {
+			next, err := dw.skip(pc)
+			return next, true, err
+		}
+		return pc + 2, true, nil
+
+	case op&0xfe0f == 0x9000: // LDS
+		k, err := dw.flashWord(pc + 2)
+		if err != nil {
+			return 0, true, err
+		}
+		v, err := dw.readData(k)
+		if err != nil {
+			return 0, true, err
+		}
+		if err := dw.writeRegisters(d, []byte{v}); err != nil {
+			return 0, true, err
+		}
+		return pc + 4, true, nil
+
+	case op&0xfe0f == 0x9200: // STS
+		k, err := dw.flashWord(pc + 2)
+		if err != nil {
+			return 0, true, err
+		}
+		v, err := dw.readReg(d)
+		if err != nil {
+			return 0, true, err
+		}
+		if err := dw.writeData(k, v); err != nil {
+			return 0, true, err
+		}
+		return pc + 4, true, nil
+	}
+
+	return 0, false, nil
+}
+
+// stepBreakpoint executes the original instruction of the software breakpoint
+// at pc, and leaves the target halted at the next instruction.
+func (dw *DebugWIRE) stepBreakpoint(pc uint16, op uint16) error {
+	next, ok, err := dw.emulate(pc, op)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if err := dw.writeInstruction(op); err != nil {
+			return err
+		}
+		next = pc + 2
+	}
+	return dw.setPC(next)
+}
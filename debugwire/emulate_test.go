@@ -0,0 +1,329 @@
+package debugwire
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/dwtk/devices"
+	"github.com/dwtk/dwtk/debugwire/adapters"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+)
+
+type cpuState struct {
+	PC    uint16
+	SP    uint16
+	SREG  byte
+	Regs  []byte
+	Data  []byte
+	Stack []byte
+}
+
+func readCPUState(t *testing.T, dw *DebugWIRE) *cpuState {
+	t.Helper()
+
+	var err error
+	rv := &cpuState{
+		Regs:  make([]byte, 32),
+		Data:  make([]byte, 2),
+		Stack: make([]byte, 8),
+	}
+	if rv.PC, err = dw.GetPC(); err != nil {
+		t.Fatal(err)
+	}
+	if rv.SP, err = dw.GetSP(); err != nil {
+		t.Fatal(err)
+	}
+	if rv.SREG, err = dw.GetSREG(); err != nil {
+		t.Fatal(err)
+	}
+	if err := dw.ReadRegisters(0, rv.Regs); err != nil {
+		t.Fatal(err)
+	}
+	if err := dw.ReadSRAM(0x100, rv.Data); err != nil {
+		t.Fatal(err)
+	}
+	if err := dw.ReadSRAM(0x8f8, rv.Stack); err != nil {
+		t.Fatal(err)
+	}
+	return rv
+}
+
+// executeReal steps the target from address 0 until it reaches addr, and
+// executes the instruction there.
+func executeReal(t *testing.T, src string, addr uint16) *cpuState {
+	t.Helper()
+
+	dw := newTestDebugWIRE(t, src)
+	defer dw.Close()
+
+	for i := 0; ; i++ {
+		pc, err := dw.GetPC()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pc == addr {
+			break
+		}
+		if i == 100 {
+			t.Fatalf("0x%04x not reached", addr)
+		}
+		if err := dw.Step(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := dw.Step(); err != nil {
+		t.Fatal(err)
+	}
+	return readCPUState(t, dw)
+}
+
+// executeEmulated runs the target from address 0 to a software breakpoint at
+// addr, and steps out of it, emulating the original instruction.
+func executeEmulated(t *testing.T, src string, addr uint16, mnemonic string) *cpuState {
+	t.Helper()
+
+	dw := newTestDebugWIRE(t, src)
+	defer dw.Close()
+
+	inst, err := dw.decode(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inst.Mnemonic != mnemonic {
+		t.Fatalf("bad instruction at 0x%04x: %s", addr, inst.Mnemonic)
+	}
+
+	// the hardware breakpoint is reserved, so the breakpoint goes to flash.
+	if !dw.SetHwBreakpoint(0x7ffe) {
+		t.Fatal("hardware breakpoint not available")
+	}
+	if err := dw.SetSwBreakpoint(addr); err != nil {
+		t.Fatal(err)
+	}
+	continueToHalt(t, dw)
+	checkPC(t, dw, addr)
+	if !dw.HasSwBreakpoints() {
+		t.Fatal("breakpoint not written to flash")
+	}
+
+	if err := dw.Step(); err != nil {
+		t.Fatal(err)
+	}
+	return readCPUState(t, dw)
+}
+
+func TestEmulate(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		addr     uint16
+		mnemonic string
+		src      string
+	}{
+		{"skip/cpse equal", 0x04, "cpse", `
+			ldi r16, 1
+			ldi r17, 1
+			cpse r16, r17
+			inc r20
+			inc r21
+		`},
+		{"skip/cpse equal two-word", 0x04, "cpse", `
+			ldi r16, 1
+			ldi r17, 1
+			cpse r16, r17
+			lds r20, 0x100
+			inc r21
+		`},
+		{"skip/cpse not equal", 0x04, "cpse", `
+			ldi r16, 1
+			ldi r17, 2
+			cpse r16, r17
+			inc r20
+			inc r21
+		`},
+		{"skip/sbrc set", 0x02, "sbrc", `
+			ldi r16, 0x08
+			sbrc r16, 3
+			inc r20
+			inc r21
+		`},
+		{"skip/sbrs set two-word", 0x02, "sbrs", `
+			ldi r16, 0x08
+			sbrs r16, 3
+			jmp 0
+			inc r21
+		`},
+		{"skip/sbic set", 0x04, "sbic", `
+			ldi r16, 0x04
+			out 0x1e, r16
+			sbic 0x1e, 2
+			inc r20
+			inc r21
+		`},
+		{"skip/sbis set", 0x04, "sbis", `
+			ldi r16, 0x04
+			out 0x1e, r16
+			sbis 0x1e, 2
+			inc r20
+			inc r21
+		`},
+		{"branch/breq taken", 0x02, "breq", `
+			cp r16, r16
+			breq skip
+			inc r20
+		skip:
+			inc r21
+		`},
+		{"branch/brne not taken", 0x02, "brne", `
+			cp r16, r16
+			brne skip
+			inc r20
+		skip:
+			inc r21
+		`},
+		{"branch/brcs backward", 0x06, "brcs", `
+			rjmp start
+		back:
+			inc r20
+		start:
+			sec
+			brcs back
+			inc r21
+		`},
+		{"branch/rjmp", 0x02, "rjmp", `
+			nop
+			rjmp skip
+			inc r20
+		skip:
+			inc r21
+		`},
+		{"two-word/jmp", 0x02, "jmp", `
+			nop
+			jmp skip
+			inc r20
+		skip:
+			inc r21
+		`},
+		{"two-word/lds", 0x06, "lds", `
+			ldi r16, 0x5a
+			sts 0x100, r16
+			lds r20, 0x100
+			inc r21
+		`},
+		{"two-word/lds register", 0x02, "lds", `
+			ldi r16, 0x5a
+			lds r20, 16
+			inc r21
+		`},
+		{"two-word/sts", 0x02, "sts", `
+			ldi r16, 0x5a
+			sts 0x101, r16
+			inc r21
+		`},
+		{"two-word/sts sreg", 0x02, "sts", `
+			ldi r16, 0x03
+			sts 0x5f, r16
+			inc r21
+		`},
+		{"stack/push", 0x02, "push", `
+			ldi r16, 0x5a
+			push r16
+			inc r21
+		`},
+		{"stack/pop", 0x04, "pop", `
+			ldi r16, 0x5a
+			push r16
+			pop r17
+			inc r21
+		`},
+		{"call/call", 0x02, "call", `
+			nop
+			call func
+			inc r20
+		func:
+			inc r21
+			ret
+		`},
+		{"call/rcall", 0x02, "rcall", `
+			nop
+			rcall func
+			inc r20
+		func:
+			inc r21
+			ret
+		`},
+		{"call/icall", 0x04, "icall", `
+			ldi r30, 4
+			ldi r31, 0
+			icall
+			inc r20
+		func:
+			inc r21
+			ret
+		`},
+		{"call/ijmp", 0x04, "ijmp", `
+			ldi r30, 4
+			ldi r31, 0
+			ijmp
+			inc r20
+		func:
+			inc r21
+		`},
+		{"call/ret", 0x06, "ret", `
+			rcall func
+			inc r20
+		loop:
+			rjmp loop
+		func:
+			ret
+		`},
+		{"call/reti", 0x06, "reti", `
+			rcall func
+			inc r20
+		loop:
+			rjmp loop
+		func:
+			reti
+		`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			real := executeReal(t, tc.src, tc.addr)
+			emulated := executeEmulated(t, tc.src, tc.addr, tc.mnemonic)
+			if !reflect.DeepEqual(real, emulated) {
+				t.Fatalf("emulation mismatch:\nreal:     %+v\nemulated: %+v", real, emulated)
+			}
+		})
+	}
+}
+
+func TestNoCPURegisters(t *testing.T) {
+	dw, err := New(&adapters.Options{Sim: "atmega328p"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dw.Close()
+
+	// UPDI devices don't describe SP and SREG.
+	dw.MCU = &noCPURegisters{dw.MCU}
+	if _, err := dw.GetSP(); err == nil {
+		t.Fatal("SP read succeeded")
+	}
+	if err := dw.SetSREG(0); err == nil {
+		t.Fatal("SREG write succeeded")
+	}
+	if err := dw.StepOver(context.Background()); err == nil {
+		t.Fatal("step over succeeded")
+	}
+}
+
+type noCPURegisters struct {
+	common.MCU
+}
+
+func (m *noCPURegisters) SP() devices.SFR {
+	return devices.SFR{}
+}
+
+func (m *noCPURegisters) SREG() devices.SFR {
+	return devices.SFR{}
+}
@@ -1,10 +1,25 @@
 package debugwire
 
 import (
+	"context"
 	"fmt"
 )
 
-func (dw *DebugWIRE) WriteFlashPage(start uint16, b []byte) error {
+// WriteFlashPage writes a page, unless ctx is done.
+func (dw *DebugWIRE) WriteFlashPage(ctx context.Context, start uint16, b []byte) error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+
+	if err := interrupted(ctx, "flash", start); err != nil {
+		return err
+	}
+	if err := dw.writeFlashPage(start, b); err != nil {
+		return err
+	}
+	return dw.forgetBreakpoints(start, uint32(len(b)))
+}
+
+func (dw *DebugWIRE) writeFlashPage(start uint16, b []byte) error {
 	if uint16(len(b)) != dw.MCU.FlashPageSize() {
 		return fmt.Errorf("debugwire: flash: page size must be 0x%04x for %s",
 			dw.MCU.FlashPageSize(),
@@ -26,24 +41,40 @@ func (dw *DebugWIRE) WriteFlashPage(start uint16, b []byte) error {
 		)
 	}
 
-	cache, err := dw.cache(0, 1, 29, 30, 31)
-	if err != nil {
+	if err := dw.clobber(0, 1, 29, 30, 31); err != nil {
 		return err
 	}
-	defer cache.restore()
+	dw.invalidateFlash(start, uint16(len(b)))
 
 	return dw.adapter.WriteFlashPage(start, b)
 }
 
 func (dw *DebugWIRE) WriteFlashInstruction(start uint16, inst uint16) error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+
 	c := []byte{
 		byte(inst),
 		byte(inst >> 8),
 	}
-	return dw.WriteFlash(start, c)
+	if err := dw.writeFlash(context.Background(), start, c); err != nil {
+		return err
+	}
+	return dw.forgetBreakpoints(start, uint32(len(c)))
+}
+
+// WriteFlash writes b to flash, rewriting the pages it touches. If ctx is
+// done, it stops before the next page.
+func (dw *DebugWIRE) WriteFlash(ctx context.Context, start uint16, b []byte) error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	if err := dw.writeFlash(ctx, start, b); err != nil {
+		return err
+	}
+	return dw.forgetBreakpoints(start, uint32(len(b)))
 }
 
-func (dw *DebugWIRE) WriteFlash(start uint16, b []byte) error {
+func (dw *DebugWIRE) writeFlash(ctx context.Context, start uint16, b []byte) error {
 	if start+uint16(len(b)) > dw.MCU.FlashSize() {
 		return fmt.Errorf("debugwire: flash: writing out of flash space: 0x%04x + 0x%04x > 0x%04x",
 			start,
@@ -52,11 +83,10 @@ func (dw *DebugWIRE) WriteFlash(start uint16, b []byte) error {
 		)
 	}
 
-	cache, err := dw.cache(0, 1, 29, 30, 31)
-	if err != nil {
+	if err := dw.clobber(0, 1, 29, 30, 31); err != nil {
 		return err
 	}
-	defer cache.restore()
+	dw.invalidateFlash(start, uint16(len(b)))
 
 	startPage := start / dw.MCU.FlashPageSize()
 	endAddr := start + uint16(len(b))
@@ -68,6 +98,9 @@ func (dw *DebugWIRE) WriteFlash(start uint16, b []byte) error {
 		addr := i * dw.MCU.FlashPageSize()
 		page := make([]byte, dw.MCU.FlashPageSize())
 
+		if err := interrupted(ctx, "flash", addr); err != nil {
+			return err
+		}
 		if err := dw.adapter.ReadFlash(addr, page); err != nil {
 			return err
 		}
@@ -97,6 +130,9 @@ func (dw *DebugWIRE) WriteFlash(start uint16, b []byte) error {
 			k++
 		}
 
+		if err := interrupted(ctx, "flash", addr); err != nil {
+			return err
+		}
 		if err := dw.adapter.WriteFlashPage(addr, page); err != nil {
 			return err
 		}
@@ -105,7 +141,15 @@ func (dw *DebugWIRE) WriteFlash(start uint16, b []byte) error {
 	return nil
 }
 
-func (dw *DebugWIRE) EraseFlashPage(start uint16) error {
+// EraseFlashPage erases a page, unless ctx is done.
+func (dw *DebugWIRE) EraseFlashPage(ctx context.Context, start uint16) error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+
+	if err := interrupted(ctx, "flash", start); err != nil {
+		return err
+	}
+
 	if start%dw.MCU.FlashPageSize() != 0 {
 		return fmt.Errorf("debugwire: flash: start address must be aligned to page start (page size: 0x%04x)",
 			dw.MCU.FlashPageSize(),
@@ -120,17 +164,46 @@ func (dw *DebugWIRE) EraseFlashPage(start uint16) error {
 		)
 	}
 
-	cache, err := dw.cache(0, 1, 29, 30, 31)
-	if err != nil {
+	if err := dw.clobber(0, 1, 29, 30, 31); err != nil {
 		return err
 	}
-	defer cache.restore()
+	dw.invalidateFlash(start, dw.MCU.FlashPageSize())
 
-	return dw.adapter.EraseFlashPage(start)
+	if err := dw.adapter.EraseFlashPage(start); err != nil {
+		return err
+	}
+	return dw.forgetBreakpoints(start, uint32(dw.MCU.FlashPageSize()))
 }
 
-func (dw *DebugWIRE) ReadFlash(start uint16, b []byte) error {
-	if start+uint16(len(b)) > dw.MCU.FlashSize() {
+// ReadFlash reads flash by pages. If ctx is done, it stops before the next
+// page. Software breakpoints are hidden, the original instructions are
+// returned instead.
+func (dw *DebugWIRE) ReadFlash(ctx context.Context, start uint16, b []byte) error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	return dw.readFlash(ctx, start, b)
+}
+
+func (dw *DebugWIRE) readFlash(ctx context.Context, start uint16, b []byte) error {
+	if err := dw.readRawFlash(ctx, start, b); err != nil {
+		return err
+	}
+
+	end := uint32(start) + uint32(len(b))
+	for a, inst := range dw.breakpoints.patched {
+		for i, v := range []byte{byte(inst), byte(inst >> 8)} {
+			if addr := uint32(a) + uint32(i); addr >= uint32(start) && addr < end {
+				b[addr-uint32(start)] = v
+			}
+		}
+	}
+	return nil
+}
+
+// readRawFlash reads the flash as is, including the BREAK instructions of
+// the software breakpoints.
+func (dw *DebugWIRE) readRawFlash(ctx context.Context, start uint16, b []byte) error {
+	if uint32(start)+uint32(len(b)) > uint32(dw.MCU.FlashSize()) {
 		return fmt.Errorf("debugwire: flash: reading out of flash space: 0x%04x + 0x%04x > 0x%04x",
 			start,
 			len(b),
@@ -138,11 +211,30 @@ func (dw *DebugWIRE) ReadFlash(start uint16, b []byte) error {
 		)
 	}
 
-	cache, err := dw.cache(30, 31)
-	if err != nil {
-		return err
-	}
-	defer cache.restore()
+	size := dw.MCU.FlashPageSize()
+	for i := 0; i < len(b); {
+		addr := start + uint16(i)
+		if err := interrupted(ctx, "flash", addr); err != nil {
+			return err
+		}
 
-	return dw.adapter.ReadFlash(start, b)
+		n := int(size - addr%size)
+		if n > len(b)-i {
+			n = len(b) - i
+		}
+		if dw.Cache {
+			if err := dw.readCachedFlash(addr, b[i:i+n]); err != nil {
+				return err
+			}
+		} else {
+			if err := dw.clobber(30, 31); err != nil {
+				return err
+			}
+			if err := dw.adapter.ReadFlash(addr, b[i:i+n]); err != nil {
+				return err
+			}
+		}
+		i += n
+	}
+	return nil
 }
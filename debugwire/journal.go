@@ -0,0 +1,313 @@
+package debugwire
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dwtk/dwtk/avr"
+)
+
+// the software breakpoints written to flash are journalled before the flash
+// write, one entry per line: "<adapter> <signature> <address> <instruction>",
+// with the adapter ID quoted. the original instructions can be restored from
+// there if dwtk doesn't exit cleanly.
+
+type journalEntry struct {
+	adapter   string
+	signature uint16
+	address   uint16
+	inst      uint16
+}
+
+func JournalPath() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "dwtk", "breakpoints")
+}
+
+// loadJournal ignores invalid entries.
+func loadJournal() ([]*journalEntry, error) {
+	p := JournalPath()
+	if p == "" {
+		return nil, fmt.Errorf("debugwire: journal: no cache directory available")
+	}
+
+	fp, err := os.Open(p)
+	if os.IsNotExist(err) {
+		return []*journalEntry{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer fp.Close()
+
+	rv := []*journalEntry{}
+	scanner := bufio.NewScanner(fp)
+	for scanner.Scan() {
+		e := &journalEntry{}
+		if _, err := fmt.Sscanf(scanner.Text(), "%q %v %v %v", &e.adapter, &e.signature, &e.address, &e.inst); err != nil {
+			continue
+		}
+		rv = append(rv, e)
+	}
+	return rv, scanner.Err()
+}
+
+// saveJournal replaces the journal atomically, and makes sure that it reached
+// the disk.
+func saveJournal(entries []*journalEntry) error {
+	p := JournalPath()
+	if p == "" {
+		return fmt.Errorf("debugwire: journal: no cache directory available")
+	}
+
+	var b strings.Builder
+	for _, e := range entries {
+		fmt.Fprintf(&b, "%q 0x%04x 0x%04x 0x%04x\n", e.adapter, e.signature, e.address, e.inst)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+	fp, err := ioutil.TempFile(filepath.Dir(p), ".breakpoints")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(fp.Name())
+
+	if _, err := fp.WriteString(b.String()); err != nil {
+		fp.Close()
+		return err
+	}
+	if err := fp.Sync(); err != nil {
+		fp.Close()
+		return err
+	}
+	if err := fp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(fp.Name(), p)
+}
+
+// adapterId identifies the adapter in the journal, by its type and serial
+// number or port, that don't change with firmware updates.
+func (dw *DebugWIRE) adapterId() string {
+	if dw.adapterID == "" {
+		dw.adapterID = dw.adapter.ID()
+	}
+	return dw.adapterID
+}
+
+// journalAdd adds the original instructions of the new software breakpoints,
+// read from flash. If a stale entry of the same adapter exists for an address
+// that still holds a BREAK instruction, its instruction is the original one,
+// and patched is updated. Stale entries for the addresses are replaced.
+func (dw *DebugWIRE) journalAdd(patched map[uint16]uint16) error {
+	if !dw.journal || len(patched) == 0 {
+		return nil
+	}
+
+	all, err := loadJournal()
+	if err != nil {
+		return err
+	}
+	entries := []*journalEntry{}
+	for _, e := range all {
+		inst, ok := patched[e.address]
+		if !ok || !dw.journalOwns(e) {
+			entries = append(entries, e)
+			continue
+		}
+		if inst == avr.BREAK() {
+			patched[e.address] = e.inst
+		}
+	}
+	for a, inst := range patched {
+		entries = append(entries, &journalEntry{
+			adapter:   dw.adapterId(),
+			signature: dw.MCU.Signature(),
+			address:   a,
+			inst:      inst,
+		})
+	}
+	return saveJournal(entries)
+}
+
+// journalOwns checks if the entry was written for the target, using the
+// current adapter. Entries of other adapters are only touched by
+// RecoverBreakpoints.
+func (dw *DebugWIRE) journalOwns(e *journalEntry) bool {
+	return e.signature == dw.MCU.Signature() && e.adapter == dw.adapterId()
+}
+
+// journalDrop removes the entries of the target in the flash range, that is
+// being rewritten and won't hold the BREAK instructions anymore. Use
+// forgetBreakpoints, that updates the breakpoints too.
+func (dw *DebugWIRE) journalDrop(start uint16, size uint32) error {
+	if !dw.journal {
+		return nil
+	}
+
+	entries, err := loadJournal()
+	if err != nil {
+		return err
+	}
+	rv := []*journalEntry{}
+	for _, e := range entries {
+		if dw.journalOwns(e) && e.address >= start && uint32(e.address) < uint32(start)+size {
+			continue
+		}
+		rv = append(rv, e)
+	}
+	if len(rv) == len(entries) {
+		return nil
+	}
+	return saveJournal(rv)
+}
+
+// journalRemove removes the entries of the target for the restored
+// addresses.
+func (dw *DebugWIRE) journalRemove(restored map[uint16]bool) error {
+	if !dw.journal || len(restored) == 0 {
+		return nil
+	}
+
+	entries, err := loadJournal()
+	if err != nil {
+		return err
+	}
+	rv := []*journalEntry{}
+	for _, e := range entries {
+		if dw.journalOwns(e) && restored[e.address] {
+			continue
+		}
+		rv = append(rv, e)
+	}
+	return saveJournal(rv)
+}
+
+// staleJournalEntries returns the entries for the target device that weren't
+// written by this session. Entries from other adapters are only included if
+// allAdapters is set.
+func (dw *DebugWIRE) staleJournalEntries(allAdapters bool) ([]*journalEntry, error) {
+	if !dw.journal {
+		return nil, nil
+	}
+
+	entries, err := loadJournal()
+	if err != nil {
+		return nil, err
+	}
+	rv := []*journalEntry{}
+	for _, e := range entries {
+		if e.signature != dw.MCU.Signature() {
+			continue
+		}
+		if e.adapter != dw.adapterId() {
+			if allAdapters {
+				rv = append(rv, e)
+			}
+			continue
+		}
+		if _, ok := dw.breakpoints.patched[e.address]; !ok {
+			rv = append(rv, e)
+		}
+	}
+	return rv, nil
+}
+
+// StaleBreakpoints returns how many software breakpoints were left in the
+// target's flash by previous sessions using the same adapter.
+func (dw *DebugWIRE) StaleBreakpoints() (int, error) {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+
+	entries, err := dw.staleJournalEntries(false)
+	return len(entries), err
+}
+
+// RecoverBreakpoints restores the original instructions of the software
+// breakpoints left in flash by previous sessions, and returns how many were
+// restored. Addresses that don't hold a BREAK instruction anymore were
+// flashed again since, and are just dropped from the journal.
+func (dw *DebugWIRE) RecoverBreakpoints(allAdapters bool) (int, error) {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+
+	entries, err := dw.staleJournalEntries(allAdapters)
+	if err != nil {
+		return 0, err
+	}
+
+	page := dw.MCU.FlashPageSize()
+	pages := make(map[uint16][]*journalEntry)
+	starts := []uint16{}
+	for _, e := range entries {
+		p := e.address - e.address%page
+		if _, ok := pages[p]; !ok {
+			starts = append(starts, p)
+		}
+		pages[p] = append(pages[p], e)
+	}
+
+	rv := 0
+	done := make(map[*journalEntry]bool)
+	for _, p := range starts {
+		n, err := dw.recoverPage(p, pages[p])
+		if err != nil {
+			return rv, err
+		}
+		rv += n
+		for _, e := range pages[p] {
+			done[e] = true
+		}
+	}
+
+	all, err := loadJournal()
+	if err != nil {
+		return rv, err
+	}
+	keep := []*journalEntry{}
+	for _, e := range all {
+		drop := false
+		for d := range done {
+			if *d == *e {
+				drop = true
+				break
+			}
+		}
+		if !drop {
+			keep = append(keep, e)
+		}
+	}
+	return rv, saveJournal(keep)
+}
+
+func (dw *DebugWIRE) recoverPage(start uint16, entries []*journalEntry) (int, error) {
+	b := make([]byte, dw.MCU.FlashPageSize())
+	if err := dw.readRawFlash(context.Background(), start, b); err != nil {
+		return 0, err
+	}
+
+	rv := 0
+	for _, e := range entries {
+		i := e.address - start
+		if (uint16(b[i+1])<<8)|uint16(b[i]) != avr.BREAK() {
+			continue
+		}
+		b[i] = byte(e.inst)
+		b[i+1] = byte(e.inst >> 8)
+		rv++
+	}
+	if rv == 0 {
+		return 0, nil
+	}
+	return rv, dw.writeFlashPage(start, b)
+}
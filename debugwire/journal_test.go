@@ -0,0 +1,202 @@
+package debugwire
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/dwtk/dwtk/avr"
+)
+
+// newJournalTarget returns a simulated target with the journal enabled, in a
+// temporary cache directory, and the hardware breakpoint reserved.
+func newJournalTarget(t *testing.T) (*DebugWIRE, []byte, func()) {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "dwtk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache, cacheSet := os.LookupEnv("XDG_CACHE_HOME")
+	os.Setenv("XDG_CACHE_HOME", dir)
+	cleanup := func() {
+		if cacheSet {
+			os.Setenv("XDG_CACHE_HOME", cache)
+		} else {
+			os.Unsetenv("XDG_CACHE_HOME")
+		}
+		os.RemoveAll(dir)
+	}
+
+	dw, _, orig := newBreakpointsTarget(t)
+	dw.journal = true
+	dw.SetHwBreakpoint(0x7ffe)
+	return dw, orig, func() {
+		dw.Close()
+		cleanup()
+	}
+}
+
+func checkJournal(t *testing.T, exp []*journalEntry) {
+	t.Helper()
+
+	entries, err := loadJournal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(entries, exp) {
+		t.Fatalf("bad journal:\n%+v !=\n%+v", entries, exp)
+	}
+}
+
+// foreignEntries are journal entries of another adapter, and of another
+// device, at the addresses used by the tests.
+func foreignEntries(dw *DebugWIRE) []*journalEntry {
+	return []*journalEntry{
+		{adapter: "dwtk-ice 0123abcd", signature: dw.MCU.Signature(), address: 2, inst: 0x0000},
+		{adapter: dw.adapterId(), signature: 0x9205, address: 4, inst: 0x0000},
+	}
+}
+
+func TestJournalRemove(t *testing.T) {
+	dw, _, cleanup := newJournalTarget(t)
+	defer cleanup()
+	foreign := foreignEntries(dw)
+	if err := saveJournal(foreign); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, a := range []uint16{2, 4} {
+		if err := dw.SetSwBreakpoint(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	continueToHalt(t, dw)
+	checkPC(t, dw, 2)
+
+	entries, err := loadJournal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 4 {
+		t.Fatalf("breakpoints not journalled: %+v", entries)
+	}
+
+	if err := dw.ClearSwBreakpoints(); err != nil {
+		t.Fatal(err)
+	}
+	checkJournal(t, foreign)
+}
+
+func TestJournalRecover(t *testing.T) {
+	dw, orig, cleanup := newJournalTarget(t)
+	defer cleanup()
+	foreign := foreignEntries(dw)
+	if err := saveJournal(foreign); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, a := range []uint16{2, 4} {
+		if err := dw.SetSwBreakpoint(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	continueToHalt(t, dw)
+
+	// a new session, after dwtk didn't exit cleanly.
+	dw.breakpoints = newBreakpoints()
+
+	n, err := dw.StaleBreakpoints()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatalf("bad stale breakpoints: %d", n)
+	}
+
+	n, err = dw.RecoverBreakpoints(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatalf("bad recovered breakpoints: %d", n)
+	}
+	b := make([]byte, len(orig))
+	if err := dw.adapter.ReadFlash(0, b); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(b, orig) {
+		t.Fatalf("flash not restored: %v != %v", b, orig)
+	}
+	checkJournal(t, foreign)
+}
+
+func TestJournalForeignEntries(t *testing.T) {
+	dw, _, cleanup := newJournalTarget(t)
+	defer cleanup()
+	foreign := foreignEntries(dw)
+
+	// a BREAK left in flash by another adapter.
+	if err := dw.WriteFlashInstruction(2, avr.BREAK()); err != nil {
+		t.Fatal(err)
+	}
+	if err := saveJournal(foreign); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := dw.StaleBreakpoints()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Fatalf("foreign entries counted: %d", n)
+	}
+	if n, err := dw.RecoverBreakpoints(false); err != nil || n != 0 {
+		t.Fatalf("foreign entries recovered: %d, %v", n, err)
+	}
+	checkJournal(t, foreign)
+
+	// the entry of the other device is kept.
+	n, err = dw.RecoverBreakpoints(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Fatalf("bad recovered breakpoints: %d", n)
+	}
+	if w := rawFlashWord(t, dw, 2); w != 0x0000 {
+		t.Fatalf("instruction not restored: 0x%04x", w)
+	}
+	checkJournal(t, foreign[1:])
+}
+
+func TestJournalFlashRewritten(t *testing.T) {
+	dw, _, cleanup := newJournalTarget(t)
+	defer cleanup()
+
+	if err := dw.SetSwBreakpoint(2); err != nil {
+		t.Fatal(err)
+	}
+	continueToHalt(t, dw)
+	checkPC(t, dw, 2)
+
+	// inc r17
+	if err := dw.WriteFlashInstruction(2, 0x9513); err != nil {
+		t.Fatal(err)
+	}
+	checkJournal(t, []*journalEntry{})
+	if dw.HasSwBreakpoints() {
+		t.Fatal("rewritten breakpoint still in flash")
+	}
+
+	// the new instruction is journalled, not the stale one.
+	if err := dw.SetPC(0); err != nil {
+		t.Fatal(err)
+	}
+	continueToHalt(t, dw)
+	checkPC(t, dw, 2)
+	checkJournal(t, []*journalEntry{
+		{adapter: dw.adapterId(), signature: dw.MCU.Signature(), address: 2, inst: 0x9513},
+	})
+}
@@ -0,0 +1,135 @@
+package debugwire
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+)
+
+const (
+	debugWIRECaps = common.CapDebugWIRE | common.CapTimers
+	spiCaps       = common.CapSpiIsp | common.CapFuseWrite | common.CapChipErase
+
+	powerCycleTimeout = 60 * time.Second
+)
+
+// RequireMode checks if the adapter provides the capabilities. If it doesn't,
+// AutoMode is set and the adapter can switch modes, the target is moved to the
+// mode that provides them. The returned function moves it back. The progress
+// of the mode switches, and the instructions for the user, are written to w.
+func (dw *DebugWIRE) RequireMode(caps common.Capabilities, w io.Writer) (func() error, error) {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+
+	noop := func() error { return nil }
+
+	c := dw.adapter.Capabilities()
+	if c.Has(caps) || !dw.AutoMode || !c.Has(common.CapModeSwitch) {
+		return noop, c.Require(caps)
+	}
+	if caps&debugWIRECaps != 0 && caps&spiCaps != 0 {
+		return noop, c.Require(caps)
+	}
+
+	var restore func() error
+	var err error
+	if c.Has(common.CapDebugWIRE) {
+		restore, err = dw.enterSpiMode(w)
+	} else {
+		restore, err = dw.enterDebugWIREMode(w)
+	}
+	if err != nil {
+		return noop, err
+	}
+
+	if err := dw.adapter.Capabilities().Require(caps); err != nil {
+		restore()
+		return noop, err
+	}
+	return func() error {
+		dw.mutex.Lock()
+		defer dw.mutex.Unlock()
+		return restore()
+	}, nil
+}
+
+func (dw *DebugWIRE) enterSpiMode(w io.Writer) (func() error, error) {
+	fmt.Fprintln(w, "Switching target to SPI ISP mode ...")
+	if err := dw.adapter.SwitchMode(false); err != nil {
+		return nil, err
+	}
+
+	return func() error {
+		fuses, err := dw.adapter.ReadFuses()
+		if err != nil {
+			return err
+		}
+		if fuses[avr.HIGH_FUSE]&dw.MCU.DWENMask() != 0 {
+			fmt.Fprintln(w, "DWEN fuse is unprogrammed, target stays on SPI ISP mode.")
+			return nil
+		}
+
+		fmt.Fprintln(w, "Switching target back to debugWIRE mode ...")
+		return dw.waitDebugWIRE(w)
+	}, nil
+}
+
+func (dw *DebugWIRE) enterDebugWIREMode(w io.Writer) (func() error, error) {
+	fuses, err := dw.adapter.ReadFuses()
+	if err != nil {
+		return nil, err
+	}
+
+	programmed := false
+	if hfuse := fuses[avr.HIGH_FUSE]; hfuse&dw.MCU.DWENMask() != 0 {
+		fmt.Fprintln(w, "Programming DWEN fuse ...")
+		if err := dw.adapter.WriteHFuse(hfuse &^ dw.MCU.DWENMask()); err != nil {
+			return nil, err
+		}
+		programmed = true
+	}
+
+	fmt.Fprintln(w, "Switching target to debugWIRE mode ...")
+	if err := dw.waitDebugWIRE(w); err != nil {
+		return nil, err
+	}
+
+	return func() error {
+		fmt.Fprintln(w, "Switching target back to SPI ISP mode ...")
+		if err := dw.adapter.SwitchMode(false); err != nil {
+			return err
+		}
+		if !programmed {
+			return nil
+		}
+
+		fmt.Fprintln(w, "Unprogramming DWEN fuse ...")
+		fuses, err := dw.adapter.ReadFuses()
+		if err != nil {
+			return err
+		}
+		return dw.adapter.WriteHFuse(fuses[avr.HIGH_FUSE] | dw.MCU.DWENMask())
+	}, nil
+}
+
+// waitDebugWIRE switches the target to debugWIRE mode, asking the user for a
+// power cycle if needed.
+func (dw *DebugWIRE) waitDebugWIRE(w io.Writer) error {
+	err := dw.adapter.SwitchMode(true)
+	if !common.IsPowerCycle(err) {
+		return err
+	}
+
+	fmt.Fprintf(w, "Power cycle the target to enter debugWIRE mode (waiting up to %s) ...\n", powerCycleTimeout)
+	for deadline := time.Now().Add(powerCycleTimeout); time.Now().Before(deadline); {
+		time.Sleep(500 * time.Millisecond)
+		if err := dw.adapter.SwitchMode(true); !common.IsPowerCycle(err) {
+			return err
+		}
+	}
+	return errors.New("debugwire: timed out waiting for target power cycle")
+}
@@ -1,28 +1,179 @@
 package debugwire
 
+import (
+	"fmt"
+
+	"github.com/dwtk/devices"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+)
+
 func (dw *DebugWIRE) SetSP(b uint16) error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	return dw.withCache(func() error {
+		return dw.setSP(b)
+	})
+}
+
+func (dw *DebugWIRE) GetSP() (uint16, error) {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+
+	var rv uint16
+	err := dw.withCache(func() error {
+		var err error
+		rv, err = dw.getSP()
+		return err
+	})
+	return rv, err
+}
+
+func (dw *DebugWIRE) SetSREG(b byte) error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+	return dw.withCache(func() error {
+		return dw.setSREG(b)
+	})
+}
+
+func (dw *DebugWIRE) GetSREG() (byte, error) {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+
+	var rv byte
+	err := dw.withCache(func() error {
+		var err error
+		rv, err = dw.getSREG()
+		return err
+	})
+	return rv, err
+}
+
+// cpuRegister returns the data space address of SP or SREG. UPDI devices
+// don't describe them, as they can't be debugged.
+func (dw *DebugWIRE) cpuRegister(name string, r devices.SFR) (uint16, error) {
+	if r.Size() == 0 {
+		return 0, fmt.Errorf("debugwire: registers: %s not available for %s", name, dw.MCU.Name())
+	}
+	return r.Mem16(), nil
+}
+
+func (dw *DebugWIRE) setSP(b uint16) error {
+	addr, err := dw.cpuRegister("SP", dw.MCU.SP())
+	if err != nil {
+		return err
+	}
 	c := []byte{
 		byte(b), byte(b >> 8),
 	}
-	return dw.WriteSRAM(dw.MCU.SP().Mem16(), c)
+	return dw.writeSRAM(addr, c)
 }
 
-func (dw *DebugWIRE) GetSP() (uint16, error) {
+func (dw *DebugWIRE) getSP() (uint16, error) {
+	addr, err := dw.cpuRegister("SP", dw.MCU.SP())
+	if err != nil {
+		return 0, err
+	}
 	c := make([]byte, 2)
-	if err := dw.ReadSRAM(dw.MCU.SP().Mem16(), c); err != nil {
+	if err := dw.readSRAM(addr, c); err != nil {
 		return 0, err
 	}
 	return (uint16(c[1]) << 8) | uint16(c[0]), nil
 }
 
-func (dw *DebugWIRE) SetSREG(b byte) error {
-	return dw.WriteSRAM(dw.MCU.SREG().Mem16(), []byte{b})
+func (dw *DebugWIRE) setSREG(b byte) error {
+	addr, err := dw.cpuRegister("SREG", dw.MCU.SREG())
+	if err != nil {
+		return err
+	}
+	return dw.writeSRAM(addr, []byte{b})
 }
 
-func (dw *DebugWIRE) GetSREG() (byte, error) {
+func (dw *DebugWIRE) getSREG() (byte, error) {
+	addr, err := dw.cpuRegister("SREG", dw.MCU.SREG())
+	if err != nil {
+		return 0, err
+	}
 	c := make([]byte, 1)
-	if err := dw.ReadSRAM(dw.MCU.SREG().Mem16(), c); err != nil {
+	if err := dw.readSRAM(addr, c); err != nil {
 		return 0, err
 	}
 	return c[0], nil
 }
+
+// ReadIO reads the I/O register or bitfield named by name, e.g. "PORTB" or
+// "TCCR0B.CS0".
+func (dw *DebugWIRE) ReadIO(name string) (uint16, error) {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+
+	r, f, err := common.LookupRegister(dw.MCU, name)
+	if err != nil {
+		return 0, err
+	}
+
+	var v uint16
+	if err := dw.withCache(func() error {
+		var err error
+		v, err = dw.readIO(r)
+		return err
+	}); err != nil {
+		return 0, err
+	}
+	if f != nil {
+		return f.Get(v), nil
+	}
+	return v, nil
+}
+
+// WriteIO writes the I/O register or bitfield named by name. Bitfields are
+// written with a read-modify-write of their register.
+func (dw *DebugWIRE) WriteIO(name string, v uint16) error {
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+
+	r, f, err := common.LookupRegister(dw.MCU, name)
+	if err != nil {
+		return err
+	}
+	if f == nil && r.Size == 1 && v > 0xff {
+		return fmt.Errorf("debugwire: registers: invalid value for %s: 0x%x", r.Name, v)
+	}
+
+	return dw.withCache(func() error {
+		if f != nil {
+			cur, err := dw.readIO(r)
+			if err != nil {
+				return err
+			}
+			v, err = f.Set(cur, v)
+			if err != nil {
+				return err
+			}
+		}
+		return dw.writeIO(r, v)
+	})
+}
+
+// 16-bit registers are read low byte first and written high byte first, as
+// the AVR latches the other byte in a temporary register.
+func (dw *DebugWIRE) readIO(r *common.Register) (uint16, error) {
+	rv := uint16(0)
+	for i := byte(0); i < r.Size; i++ {
+		b := make([]byte, 1)
+		if err := dw.readSRAM(r.Address+uint16(i), b); err != nil {
+			return 0, err
+		}
+		rv |= uint16(b[0]) << (8 * i)
+	}
+	return rv, nil
+}
+
+func (dw *DebugWIRE) writeIO(r *common.Register, v uint16) error {
+	for i := r.Size; i > 0; i-- {
+		if err := dw.writeSRAM(r.Address+uint16(i-1), []byte{byte(v >> (8 * (i - 1)))}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package debugwire
+
+import (
+	"testing"
+)
+
+func TestIOKeepsState(t *testing.T) {
+	dw := newClobberingTarget(t, `
+	loop:
+		rjmp loop
+	`)
+	defer dw.Close()
+
+	if err := dw.WriteIO("TCCR0B", 0x08); err != nil {
+		t.Fatal(err)
+	}
+	if err := dw.WriteIO("TCCR0B.CS0", 5); err != nil {
+		t.Fatal(err)
+	}
+	v, err := dw.ReadIO("TCCR0B")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 0x0d {
+		t.Fatalf("bad TCCR0B: 0x%02x != 0x0d", v)
+	}
+
+	// the target resumes where it was halted, with its registers.
+	checkPC(t, dw, 0)
+	checkReg(t, dw, 30, 0)
+	checkReg(t, dw, 31, 0)
+}
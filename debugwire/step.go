@@ -0,0 +1,274 @@
+package debugwire
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+)
+
+const sregI = 0x80
+
+// decode decodes the instruction at addr, as it was before any software
+// breakpoint was written.
+func (dw *DebugWIRE) decode(addr uint16) (*avr.Instruction, error) {
+	op, err := dw.flashWord(addr)
+	if err != nil {
+		return nil, err
+	}
+	next := uint16(0)
+	if avr.IsTwoWord(op) && addr+2 < dw.MCU.FlashSize() {
+		next, err = dw.flashWord(addr + 2)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return avr.Decode(addr, op, next, common.InstructionSet(dw.MCU)), nil
+}
+
+// usesInterruptFlag reports if the instruction reads or writes the global
+// interrupt flag, so it can't be stepped with interrupts masked.
+func (dw *DebugWIRE) usesInterruptFlag(inst *avr.Instruction) bool {
+	switch inst.Mnemonic {
+	case "sei", "cli", "reti", "brie", "brid":
+		return true
+	case "in", "out":
+		for _, o := range inst.Operands {
+			if o.Kind == avr.OperandIO && o.Value == int(dw.MCU.SREG().Io8()) {
+				return true
+			}
+		}
+	case "lds", "sts":
+		for _, o := range inst.Operands {
+			if o.Kind == avr.OperandData && o.Value == int(dw.MCU.SREG().Mem16()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// stepMasked steps the target with interrupts disabled, so that an interrupt
+// firing during the step doesn't move the target to its handler.
+func (dw *DebugWIRE) stepMasked(inst *avr.Instruction) error {
+	sreg, err := dw.getSREG()
+	if err != nil {
+		return err
+	}
+	if sreg&sregI == 0 || dw.usesInterruptFlag(inst) {
+		return dw.step()
+	}
+
+	if err := dw.setSREG(sreg &^ sregI); err != nil {
+		return err
+	}
+	if err := dw.step(); err != nil {
+		return err
+	}
+	sreg, err = dw.getSREG()
+	if err != nil {
+		return err
+	}
+	return dw.setSREG(sreg | sregI)
+}
+
+// wait waits for the target to halt. The target is halted if ctx is done
+// first.
+func (dw *DebugWIRE) wait(ctx context.Context) error {
+	c := make(chan bool, 1)
+	if err := dw.adapter.Wait(ctx, c); err != nil {
+		return err
+	}
+
+	select {
+	case <-c:
+		return dw.adapter.RecvBreak()
+	default:
+	}
+
+	if err := dw.adapter.SendBreak(); err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+// runTo resumes the target with a temporary breakpoint at addr, and returns
+// the program counter once the target halts, that is not addr if another
+// breakpoint was hit first.
+func (dw *DebugWIRE) runTo(ctx context.Context, addr uint16) (uint16, error) {
+	pc, err := dw.getPC()
+	if err != nil {
+		return 0, err
+	}
+
+	// a breakpoint at the current program counter would be ignored or hit
+	// immediately.
+	if pc == addr || dw.isBreakpoint(pc) {
+		inst, err := dw.decode(pc)
+		if err != nil {
+			return 0, err
+		}
+		if err := dw.stepMasked(inst); err != nil {
+			return 0, err
+		}
+		pc, err = dw.getPC()
+		if err != nil {
+			return 0, err
+		}
+		if pc == addr {
+			return pc, nil
+		}
+	}
+
+	dw.breakpoints.temp, dw.breakpoints.tempSet = addr, true
+	hw, hwSet, err := dw.commitBreakpoints(nil)
+	dw.breakpoints.tempSet = false
+	if err != nil {
+		return 0, err
+	}
+	if err := dw.flushCache(); err != nil {
+		return 0, err
+	}
+	if err := dw.adapter.Continue(hw, hwSet, dw.Timers); err != nil {
+		return 0, err
+	}
+	if err := dw.wait(ctx); err != nil {
+		return 0, err
+	}
+	return dw.getPC()
+}
+
+// runToFrame runs to addr, until it is reached with the stack pointer at or
+// above sp, as recursive calls and interrupt handlers may reach addr with
+// a deeper stack.
+func (dw *DebugWIRE) runToFrame(ctx context.Context, addr uint16, sp uint16) error {
+	for {
+		pc, err := dw.runTo(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if pc != addr {
+			return nil
+		}
+		cur, err := dw.getSP()
+		if err != nil {
+			return err
+		}
+		if cur >= sp {
+			return nil
+		}
+	}
+}
+
+// running runs f with the adapter locked and the cache enabled, and a
+// context that is also canceled by SendBreak, so that SendBreak can interrupt
+// f while it waits for the target. If interrupted by SendBreak, it returns nil with the target
+// halted wherever it was.
+func (dw *DebugWIRE) running(ctx context.Context, f func(ctx context.Context) error) error {
+	wctx, done := dw.interruptible(ctx)
+	defer done()
+
+	dw.mutex.Lock()
+	defer dw.mutex.Unlock()
+
+	err := dw.withCache(func() error {
+		return f(wctx)
+	})
+	if errors.Is(err, context.Canceled) && ctx.Err() == nil {
+		return nil
+	}
+	return err
+}
+
+// RunTo resumes the target until it reaches addr (byte address) or another
+// breakpoint. It can be interrupted by SendBreak.
+func (dw *DebugWIRE) RunTo(ctx context.Context, addr uint16) error {
+	if addr%2 != 0 || addr >= dw.MCU.FlashSize() {
+		return fmt.Errorf("debugwire: step: invalid address: 0x%04x", addr)
+	}
+	return dw.running(ctx, func(ctx context.Context) error {
+		_, err := dw.runTo(ctx, addr)
+		return err
+	})
+}
+
+// StepOver steps the target, running called functions until they return. The
+// target also halts on breakpoints hit by the called functions. It can be
+// interrupted by SendBreak.
+func (dw *DebugWIRE) StepOver(ctx context.Context) error {
+	return dw.running(ctx, dw.stepOver)
+}
+
+func (dw *DebugWIRE) stepOver(ctx context.Context) error {
+	pc, err := dw.getPC()
+	if err != nil {
+		return err
+	}
+	inst, err := dw.decode(pc)
+	if err != nil {
+		return err
+	}
+	if inst.Flow != avr.FlowCall {
+		return dw.stepMasked(inst)
+	}
+
+	sp, err := dw.getSP()
+	if err != nil {
+		return err
+	}
+	return dw.runToFrame(ctx, inst.Next(), sp)
+}
+
+// StepOut steps the target until the current function returns, running
+// called functions until they return, and halts at the caller. The return
+// address can't be read from the stack, as the function may have pushed to
+// it, so the target is stepped until a RET or RETI runs at the function's own
+// depth. The target also halts on breakpoints. It can be interrupted by
+// SendBreak.
+func (dw *DebugWIRE) StepOut(ctx context.Context) error {
+	return dw.running(ctx, dw.stepOut)
+}
+
+func (dw *DebugWIRE) stepOut(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		pc, err := dw.getPC()
+		if err != nil {
+			return err
+		}
+		inst, err := dw.decode(pc)
+		if err != nil {
+			return err
+		}
+
+		switch inst.Flow {
+		case avr.FlowCall:
+			sp, err := dw.getSP()
+			if err != nil {
+				return err
+			}
+			if err := dw.runToFrame(ctx, inst.Next(), sp); err != nil {
+				return err
+			}
+		case avr.FlowReturn:
+			return dw.stepMasked(inst)
+		default:
+			if err := dw.stepMasked(inst); err != nil {
+				return err
+			}
+		}
+
+		pc, err = dw.getPC()
+		if err != nil {
+			return err
+		}
+		if inst.Flow == avr.FlowCall && pc != inst.Next() || dw.isBreakpoint(pc) {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package debugwire
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+const stepProgram = `
+	ldi r16, 1
+	call func
+	ldi r16, 2
+loop:
+	rjmp loop
+func:
+	push r16
+	rcall nested
+	pop r16
+	ret
+nested:
+	inc r17
+	ret
+`
+
+const (
+	stepCall      = 0x02
+	stepReturn    = 0x06
+	stepFunc      = 0x0a
+	stepNested    = 0x0c
+	stepNestedRet = 0x0e
+	stepNestedInc = 0x12
+)
+
+func newStepTarget(t *testing.T) (*DebugWIRE, uint16) {
+	t.Helper()
+
+	dw := newClobberingTarget(t, stepProgram)
+	sp, err := dw.GetSP()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dw.Step(); err != nil {
+		t.Fatal(err)
+	}
+	checkPC(t, dw, stepCall)
+	return dw, sp
+}
+
+func TestStepOverCall(t *testing.T) {
+	dw, sp := newStepTarget(t)
+	defer dw.Close()
+
+	if err := dw.StepOver(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	checkPC(t, dw, stepReturn)
+	checkSP(t, dw, sp)
+	checkReg(t, dw, 17, 1)
+	checkReg(t, dw, 30, 0)
+
+	// not a call, just a step.
+	if err := dw.StepOver(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	checkPC(t, dw, stepReturn+2)
+	checkReg(t, dw, 16, 2)
+}
+
+func TestStepOverNestedCall(t *testing.T) {
+	dw, sp := newStepTarget(t)
+	defer dw.Close()
+
+	for _, exp := range []uint16{stepFunc, stepNested} {
+		if err := dw.Step(); err != nil {
+			t.Fatal(err)
+		}
+		checkPC(t, dw, exp)
+	}
+
+	if err := dw.StepOver(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	checkPC(t, dw, stepNestedRet)
+	checkSP(t, dw, sp-3)
+	checkReg(t, dw, 17, 1)
+}
+
+func TestStepOutAfterPush(t *testing.T) {
+	dw, sp := newStepTarget(t)
+	defer dw.Close()
+
+	// the return address is no longer at the top of the stack.
+	for _, exp := range []uint16{stepFunc, stepNested} {
+		if err := dw.Step(); err != nil {
+			t.Fatal(err)
+		}
+		checkPC(t, dw, exp)
+	}
+
+	if err := dw.StepOut(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	checkPC(t, dw, stepReturn)
+	checkSP(t, dw, sp)
+	checkReg(t, dw, 16, 1)
+	checkReg(t, dw, 17, 1)
+	checkReg(t, dw, 30, 0)
+}
+
+func TestStepOutBreakpoint(t *testing.T) {
+	dw, _ := newStepTarget(t)
+	defer dw.Close()
+
+	if err := dw.Step(); err != nil {
+		t.Fatal(err)
+	}
+	if err := dw.SetSwBreakpoint(stepNestedInc); err != nil {
+		t.Fatal(err)
+	}
+	if err := dw.StepOut(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	checkPC(t, dw, stepNestedInc)
+	checkReg(t, dw, 17, 0)
+}
+
+func TestRunTo(t *testing.T) {
+	dw := newClobberingTarget(t, stepProgram)
+	defer dw.Close()
+
+	// the hardware breakpoint is taken, so flash is read and written.
+	if !dw.SetHwBreakpoint(stepReturn) {
+		t.Fatal("hardware breakpoint not set")
+	}
+	if err := dw.RunTo(context.Background(), stepNestedInc); err != nil {
+		t.Fatal(err)
+	}
+	checkPC(t, dw, stepNestedInc)
+	checkReg(t, dw, 16, 1)
+	checkReg(t, dw, 30, 0)
+
+	if err := dw.RunTo(context.Background(), 1); err == nil {
+		t.Fatal("run to odd address succeeded")
+	}
+}
+
+// interrupt calls SendBreak until f returns.
+func interrupt(t *testing.T, dw *DebugWIRE, f func() error) {
+	t.Helper()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- f()
+	}()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatal(err)
+			}
+			return
+		case <-timeout:
+			t.Fatal("not interrupted by SendBreak")
+		case <-time.After(10 * time.Millisecond):
+		}
+		if err := dw.SendBreak(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestSendBreakInterrupts(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		step bool
+		f    func(dw *DebugWIRE) error
+	}{
+		{"RunTo", false, func(dw *DebugWIRE) error {
+			return dw.RunTo(context.Background(), 2)
+		}},
+		{"StepOver", false, func(dw *DebugWIRE) error {
+			return dw.StepOver(context.Background())
+		}},
+		{"StepOut", true, func(dw *DebugWIRE) error {
+			return dw.StepOut(context.Background())
+		}},
+		{"Wait", false, func(dw *DebugWIRE) error {
+			if err := dw.Continue(); err != nil {
+				return err
+			}
+			return dw.Wait(context.Background(), make(chan bool, 1))
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// the called function never returns.
+			dw := newTestDebugWIRE(t, `
+				rcall func
+				nop
+			func:
+				rjmp func
+			`)
+			defer dw.Close()
+
+			if tc.step {
+				if err := dw.Step(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			interrupt(t, dw, func() error {
+				return tc.f(dw)
+			})
+			checkPC(t, dw, 4)
+		})
+	}
+}
@@ -64,3 +64,46 @@ func (*ELF) Parse(fpath string) ([]byte, error) {
 
 	return rv, nil
 }
+
+// Symbols returns the symbols from executable sections, by address. Function
+// symbols are preferred over labels at the same address.
+func (*ELF) Symbols(fpath string) (map[uint16]string, error) {
+	f, err := elf.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if f.Machine != elf.EM_AVR {
+		return nil, fmt.Errorf("firmware: elf: invalid machine architecture: %s", f.Machine)
+	}
+
+	syms, err := f.Symbols()
+	if err != nil {
+		return nil, err
+	}
+
+	rv := make(map[uint16]string)
+	funcs := make(map[uint16]bool)
+	for _, s := range syms {
+		if s.Name == "" || s.Name[0] == '.' || s.Section >= elf.SectionIndex(len(f.Sections)) {
+			continue
+		}
+		if f.Sections[s.Section].Flags&elf.SHF_EXECINSTR == 0 {
+			continue
+		}
+		t := elf.ST_TYPE(s.Info)
+		if t != elf.STT_FUNC && t != elf.STT_NOTYPE {
+			continue
+		}
+
+		addr := uint16(s.Value)
+		if _, ok := rv[addr]; ok && (funcs[addr] || t != elf.STT_FUNC) {
+			continue
+		}
+		rv[addr] = s.Name
+		funcs[addr] = t == elf.STT_FUNC
+	}
+
+	return rv, nil
+}
@@ -5,7 +5,7 @@ import (
 	"math"
 	"os"
 
-	"github.com/dwtk/devices"
+	"github.com/dwtk/dwtk/avr"
 	"github.com/dwtk/dwtk/firmware/elf"
 	"github.com/dwtk/dwtk/firmware/hex"
 )
@@ -15,9 +15,17 @@ type Page struct {
 	Data    []byte
 }
 
+// MCU is the part of the target device description used to check and split
+// firmwares.
+type MCU interface {
+	Name() string
+	FlashSize() uint16
+	FlashPageSize() uint16
+}
+
 type Firmware struct {
 	Data []byte
-	MCU  *devices.MCU
+	MCU  MCU
 }
 
 type format interface {
@@ -32,7 +40,7 @@ var (
 	}
 )
 
-func NewFromData(data []byte, mcu *devices.MCU) (*Firmware, error) {
+func NewFromData(data []byte, mcu MCU) (*Firmware, error) {
 	if mcu == nil {
 		return nil, fmt.Errorf("firmware: MCU must be set")
 	}
@@ -51,7 +59,7 @@ func NewFromData(data []byte, mcu *devices.MCU) (*Firmware, error) {
 	}, nil
 }
 
-func NewFromFile(path string, mcu *devices.MCU) (*Firmware, error) {
+func NewFromFile(path string, mcu MCU) (*Firmware, error) {
 	if _, err := os.Stat(path); os.IsNotExist(err) {
 		return nil, err
 	}
@@ -75,6 +83,15 @@ func NewFromFile(path string, mcu *devices.MCU) (*Firmware, error) {
 	return NewFromData(data, mcu)
 }
 
+// SymbolsFromFile returns the program symbols from an ELF file.
+func SymbolsFromFile(path string) (avr.Symbols, error) {
+	e := &elf.ELF{}
+	if !e.Check(path) {
+		return nil, fmt.Errorf("firmware: symbols are only available from ELF files: %s", path)
+	}
+	return e.Symbols(path)
+}
+
 func (f *Firmware) SplitPages() []*Page {
 	pages := []*Page{}
 	n := uint16(math.Ceil(float64(len(f.Data)) / float64(f.MCU.FlashPageSize())))
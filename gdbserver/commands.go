@@ -224,7 +224,7 @@ func handleCommand(ctx context.Context, dw *debugwire.DebugWIRE, conn *tcpConn,
 		}
 
 		if a < 0x800000 {
-			if err := dw.WriteFlash(uint16(a), b); err != nil {
+			if err := dw.WriteFlash(ctx, uint16(a), b); err != nil {
 				return notifyGdb(err, []byte("E01"))
 			}
 		} else if a < 0x810000 {
@@ -232,7 +232,7 @@ func handleCommand(ctx context.Context, dw *debugwire.DebugWIRE, conn *tcpConn,
 				return notifyGdb(err, []byte("E01"))
 			}
 		} else {
-			if err := dw.WriteEEPROM(uint16(a), b); err != nil {
+			if err := dw.WriteEEPROM(ctx, uint16(a), b); err != nil {
 				return notifyGdb(err, []byte("E01"))
 			}
 		}
@@ -260,7 +260,7 @@ func handleCommand(ctx context.Context, dw *debugwire.DebugWIRE, conn *tcpConn,
 
 		b := make([]byte, c)
 		if a < 0x800000 {
-			if err := dw.ReadFlash(uint16(a), b); err != nil {
+			if err := dw.ReadFlash(ctx, uint16(a), b); err != nil {
 				return notifyGdb(err, []byte("E01"))
 			}
 		} else if a < 0x810000 {
@@ -268,7 +268,7 @@ func handleCommand(ctx context.Context, dw *debugwire.DebugWIRE, conn *tcpConn,
 				return notifyGdb(err, []byte("E01"))
 			}
 		} else {
-			if err := dw.ReadEEPROM(uint16(a), b); err != nil {
+			if err := dw.ReadEEPROM(ctx, uint16(a), b); err != nil {
 				return notifyGdb(err, []byte("E01"))
 			}
 		}
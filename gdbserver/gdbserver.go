@@ -36,7 +36,7 @@ func ListenAndServe(addr string, dw *debugwire.DebugWIRE) error {
 		return err
 	}
 
-	sigInt := make(chan os.Signal)
+	sigInt := make(chan os.Signal, 1)
 	signal.Notify(sigInt, unix.SIGINT, unix.SIGKILL, unix.SIGTERM)
 	ctx, cancel := context.WithCancel(context.Background())
 	go func() {
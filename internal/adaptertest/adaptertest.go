@@ -0,0 +1,360 @@
+// Package adaptertest implements the tests that every adapter must pass,
+// with the target simulated behind it, and helpers to write the tests that
+// are specific to an adapter.
+package adaptertest
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+	"github.com/dwtk/dwtk/internal/usbserial/usbserialtest"
+)
+
+// Adapter is the part of adapters.Adapter used by the tests. That interface
+// can't be used directly, as the adapters package imports the adapter
+// packages that are tested here.
+type Adapter interface {
+	Close() error
+	Capabilities() common.Capabilities
+	GetMCU() common.MCU
+
+	ReadSignature() (uint16, error)
+	ChipErase() error
+
+	SendBreak() error
+	RecvBreak() error
+
+	Go() error
+	Step() error
+	Continue(hwBreakpoint uint16, hwBreakpointSet bool, timers bool) error
+	Wait(ctx context.Context, c chan bool) error
+
+	SetPC(pc uint16) error
+	GetPC() (uint16, error)
+	WriteRegisters(start byte, regs []byte) error
+	ReadRegisters(start byte, regs []byte) error
+
+	WriteSRAM(start uint16, data []byte) error
+	ReadSRAM(start uint16, data []byte) error
+
+	ReadFlash(start uint16, data []byte) error
+	WriteFlashPage(start uint16, data []byte) error
+	EraseFlashPage(start uint16) error
+
+	ReadEEPROM(start uint16, data []byte) error
+	WriteEEPROM(start uint16, data []byte) error
+
+	ReadFuses() ([]byte, error)
+	WriteLFuse(data byte) error
+}
+
+// Open connects a new adapter to a new simulated target, and returns a
+// function that closes both.
+type Open func(t *testing.T) (Adapter, func())
+
+// Run runs the tests supported by the capabilities of the adapter, each one
+// with its own adapter.
+func Run(t *testing.T, open Open) {
+	tests := []struct {
+		name string
+		caps common.Capabilities
+		f    func(t *testing.T, a Adapter)
+	}{
+		{"Signature", 0, testSignature},
+		{"Flash", common.CapMemory, testFlash},
+		{"EEPROM", common.CapMemory, testEEPROM},
+		{"Fuses", 0, testFuses},
+		{"Registers", common.CapDebugWIRE, testRegisters},
+		{"SRAM", common.CapDebugWIRE, testSRAM},
+		{"StepAndBreak", common.CapDebugWIRE, testStepAndBreak},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			a, cleanup := open(t)
+			defer cleanup()
+
+			if !a.Capabilities().Has(test.caps) {
+				t.Skipf("adapter doesn't support %s", test.caps&^a.Capabilities())
+			}
+			test.f(t, a)
+		})
+	}
+}
+
+func testSignature(t *testing.T, a Adapter) {
+	sign, err := a.ReadSignature()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := a.GetMCU().Signature(); sign != exp {
+		t.Fatalf("bad signature: 0x%04x != 0x%04x", sign, exp)
+	}
+}
+
+// erase erases a flash page, or the whole chip over SPI ISP, that can't erase
+// pages.
+func erase(t *testing.T, a Adapter, start uint16) {
+	t.Helper()
+
+	if a.Capabilities().Has(common.CapSpiIsp) {
+		if err := a.ChipErase(); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	if err := a.EraseFlashPage(start); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testFlash(t *testing.T, a Adapter) {
+	size := a.GetMCU().FlashPageSize()
+	page := make([]byte, size)
+	for i := range page {
+		page[i] = byte(i * 7)
+	}
+
+	erase(t, a, size)
+	if err := a.WriteFlashPage(size, page); err != nil {
+		t.Fatal(err)
+	}
+	read := make([]byte, size)
+	if err := a.ReadFlash(size, read); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(read, page) {
+		t.Fatalf("bad flash: % x", read)
+	}
+
+	// odd start and length.
+	read = make([]byte, 5)
+	if err := a.ReadFlash(size+3, read); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(read, page[3:8]) {
+		t.Fatalf("bad unaligned flash: % x", read)
+	}
+
+	read = make([]byte, size)
+	erase(t, a, size)
+	if err := a.ReadFlash(size, read); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(read, bytes.Repeat([]byte{0xff}, int(size))) {
+		t.Fatalf("page not erased: % x", read)
+	}
+}
+
+func testEEPROM(t *testing.T, a Adapter) {
+	// on debugWIRE mode, EEPROM is accessed with instructions, by the
+	// debugwire package.
+	if a.Capabilities().Has(common.CapDebugWIRE) {
+		t.Skip("EEPROM accessed by the debugwire package")
+	}
+
+	// crossing the page boundary of the devices that have EEPROM pages.
+	data := []byte{1, 2, 3, 4, 5, 6}
+	if err := a.WriteEEPROM(0x1d, data); err != nil {
+		t.Fatal(err)
+	}
+	read := make([]byte, len(data))
+	if err := a.ReadEEPROM(0x1d, read); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(read, data) {
+		t.Fatalf("bad eeprom: % x", read)
+	}
+}
+
+func testFuses(t *testing.T, a Adapter) {
+	caps := a.Capabilities()
+	if !caps.Has(common.CapNativeFuseRead) && !caps.Has(common.CapDebugWIRE) {
+		t.Skip("adapter can't read fuses")
+	}
+
+	f, err := a.ReadFuses()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f) != 4 {
+		t.Fatalf("bad fuses: % x", f)
+	}
+	if caps.Has(common.CapDebugWIRE) && f[avr.HIGH_FUSE]&a.GetMCU().DWENMask() != 0 {
+		t.Fatalf("DWEN not programmed: % x", f)
+	}
+
+	if !caps.Has(common.CapFuseWrite) {
+		return
+	}
+	if err := a.WriteLFuse(f[avr.LOW_FUSE] ^ 0x01); err != nil {
+		t.Fatal(err)
+	}
+	g, err := a.ReadFuses()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g[avr.LOW_FUSE] != f[avr.LOW_FUSE]^0x01 {
+		t.Fatalf("low fuse not written: % x", g)
+	}
+}
+
+func testRegisters(t *testing.T, a Adapter) {
+	regs := []byte{1, 2, 3, 4}
+	if err := a.WriteRegisters(24, regs); err != nil {
+		t.Fatal(err)
+	}
+	read := make([]byte, len(regs))
+	if err := a.ReadRegisters(24, read); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(read, regs) {
+		t.Fatalf("bad registers: % x", read)
+	}
+
+	if err := a.SetPC(0x10); err != nil {
+		t.Fatal(err)
+	}
+	CheckPC(t, a, 0x10)
+}
+
+func testSRAM(t *testing.T, a Adapter) {
+	data := []byte{0xde, 0xad, 0xbe, 0xef}
+	if err := a.WriteSRAM(0x0100, data); err != nil {
+		t.Fatal(err)
+	}
+	read := make([]byte, len(data))
+	if err := a.ReadSRAM(0x0100, read); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(read, data) {
+		t.Fatalf("bad sram: % x", read)
+	}
+}
+
+func testStepAndBreak(t *testing.T, a Adapter) {
+	WriteProgram(t, a, `
+		nop
+		nop
+		break
+	loop:
+		rjmp loop
+	`)
+
+	if err := a.SetPC(0); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Step(); err != nil {
+		t.Fatal(err)
+	}
+	CheckPC(t, a, 2)
+
+	if err := a.Continue(0, false, false); err != nil {
+		t.Fatal(err)
+	}
+	WaitHalt(t, a)
+	if err := a.RecvBreak(); err != nil {
+		t.Fatal(err)
+	}
+	CheckPC(t, a, 4)
+
+	if err := a.SetPC(0); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Continue(2, true, false); err != nil {
+		t.Fatal(err)
+	}
+	WaitHalt(t, a)
+	CheckPC(t, a, 2)
+
+	// the loop only stops with a break sent by us.
+	if err := a.SetPC(6); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Go(); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if err := a.SendBreak(); err != nil {
+		t.Fatal(err)
+	}
+	CheckPC(t, a, 6)
+}
+
+// WriteProgram assembles src and writes it to the first flash page.
+func WriteProgram(t *testing.T, a Adapter, src string) {
+	t.Helper()
+
+	words, err := avr.Assemble(0, src, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page := bytes.Repeat([]byte{0xff}, int(a.GetMCU().FlashPageSize()))
+	for i, w := range words {
+		page[2*i] = byte(w)
+		page[2*i+1] = byte(w >> 8)
+	}
+	erase(t, a, 0)
+	if err := a.WriteFlashPage(0, page); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func CheckPC(t *testing.T, a Adapter, exp uint16) {
+	t.Helper()
+
+	pc, err := a.GetPC()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pc != exp {
+		t.Fatalf("bad pc: 0x%04x != 0x%04x", pc, exp)
+	}
+}
+
+// WaitHalt waits up to 5 seconds for the target to halt.
+func WaitHalt(t *testing.T, a Adapter) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c := make(chan bool, 1)
+	if err := a.Wait(ctx, c); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-c:
+	default:
+		t.Fatal("target did not halt")
+	}
+}
+
+// ServePty runs serve on a new pty, until the returned function is called.
+func ServePty(t *testing.T, serve func(ctx context.Context, pty *usbserialtest.Pty) error) (*usbserialtest.Pty, func()) {
+	t.Helper()
+
+	pty, err := usbserialtest.OpenPty()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- serve(ctx, pty)
+	}()
+
+	return pty, func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Error(err)
+		}
+		pty.Close()
+	}
+}
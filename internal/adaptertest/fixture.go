@@ -0,0 +1,63 @@
+//go:build fixture
+// +build fixture
+
+package adaptertest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"testing"
+
+	"github.com/dwtk/dwtk/internal/usbserial/usbserialtest"
+	"golang.org/x/sys/unix"
+)
+
+// FixtureSpec returns the simulator specification set in DWTK_PTY (e.g.
+// "atmega328p firmware.elf"), or skips the test. The fixture tests of the
+// adapter packages serve a fake target on a pty with it, until interrupted,
+// so that the adapters can be tried by hand from other dwtk instances. They
+// block, so they are only built with the fixture tag:
+//
+//	DWTK_PTY=atmega328p go test -tags fixture -v -timeout 0 -run Fixture ./debugwire/adapters/stk500v2
+//
+// Breaks and modem lines are only emulated on the ptys opened by the same
+// process, so protocols that need them can't be served as fixtures.
+func FixtureSpec(t *testing.T) string {
+	spec := os.Getenv("DWTK_PTY")
+	if spec == "" {
+		t.Skip("DWTK_PTY not set")
+	}
+	return spec
+}
+
+// ServeFixture runs serve on a new pty, until SIGINT or SIGTERM.
+func ServeFixture(t *testing.T, name string, serve func(ctx context.Context, pty *usbserialtest.Pty) error) {
+	t.Helper()
+
+	pty, err := usbserialtest.OpenPty()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pty.Close()
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, unix.SIGINT, unix.SIGTERM)
+	defer signal.Stop(sig)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-sig:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	fmt.Printf(" * Simulated %s available at %s\n", name, pty.Name())
+	if err := serve(ctx, pty); err != nil {
+		t.Fatal(err)
+	}
+}
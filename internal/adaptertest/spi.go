@@ -0,0 +1,154 @@
+package adaptertest
+
+import (
+	"github.com/dwtk/devices"
+	"github.com/dwtk/dwtk/avr"
+)
+
+// FakeSpiTarget is the target device behind a FakeSpi. The simulator adapter
+// implements it.
+type FakeSpiTarget interface {
+	ReadSignature() (uint16, error)
+	ChipErase() error
+	ReadFlash(start uint16, data []byte) error
+	WriteFlashPage(start uint16, data []byte) error
+	ReadEEPROM(start uint16, data []byte) error
+	WriteEEPROM(start uint16, data []byte) error
+	ReadFuses() ([]byte, error)
+	WriteLFuse(data byte) error
+	WriteHFuse(data byte) error
+	WriteEFuse(data byte) error
+	WriteLock(data byte) error
+}
+
+// FakeSpi emulates the SPI ISP instructions of a target, to be used by fake
+// programmers.
+type FakeSpi struct {
+	target     FakeSpiTarget
+	pageBuffer map[uint16]byte
+}
+
+func NewFakeSpi(target FakeSpiTarget) *FakeSpi {
+	return &FakeSpi{
+		target:     target,
+		pageBuffer: map[uint16]byte{},
+	}
+}
+
+// Reset discards the flash page buffer, like a target reset.
+func (s *FakeSpi) Reset() {
+	s.pageBuffer = map[uint16]byte{}
+}
+
+func (s *FakeSpi) mcu() (*devices.MCU, error) {
+	sign, err := s.target.ReadSignature()
+	if err != nil {
+		return nil, err
+	}
+	return devices.GetBySignature(sign)
+}
+
+// Command executes the 4 bytes SPI instruction c, and stores the 4 bytes
+// shifted out by the target in data.
+func (s *FakeSpi) Command(c []byte, data []byte) error {
+	// the target echoes the previous byte while shifting the next one in.
+	data[0] = 0
+	data[1] = c[0]
+	data[2] = c[1]
+	data[3] = c[2]
+
+	fuse := func(idx int) error {
+		fuses, err := s.target.ReadFuses()
+		if err != nil {
+			return err
+		}
+		data[3] = fuses[idx]
+		return nil
+	}
+
+	switch {
+	case c[0] == 0xac && c[1] == 0x80:
+		return s.target.ChipErase()
+
+	case c[0] == 0xf0:
+		data[3] = 0
+
+	case c[0] == 0x30:
+		sign, err := s.target.ReadSignature()
+		if err != nil {
+			return err
+		}
+		data[3] = []byte{0x1e, byte(sign >> 8), byte(sign)}[c[2]%3]
+
+	case c[0] == 0x50 && c[1] == 0x00:
+		return fuse(avr.LOW_FUSE)
+
+	case c[0] == 0x58 && c[1] == 0x08:
+		return fuse(avr.HIGH_FUSE)
+
+	case c[0] == 0x50 && c[1] == 0x08:
+		return fuse(avr.EXTENDED_FUSE)
+
+	case c[0] == 0x58 && c[1] == 0x00:
+		return fuse(avr.LOCKBIT)
+
+	case c[0] == 0xac && c[1] == 0xa0:
+		return s.target.WriteLFuse(c[3])
+
+	case c[0] == 0xac && c[1] == 0xa8:
+		return s.target.WriteHFuse(c[3])
+
+	case c[0] == 0xac && c[1] == 0xa4:
+		return s.target.WriteEFuse(c[3])
+
+	case c[0] == 0xac && c[1] == 0xe0:
+		return s.target.WriteLock(c[3])
+
+	case c[0] == 0x20 || c[0] == 0x28:
+		addr := ((uint16(c[1]) << 8) | uint16(c[2])) * 2
+		if c[0] == 0x28 {
+			addr++
+		}
+		return s.target.ReadFlash(addr, data[3:4])
+
+	case c[0] == 0x40 || c[0] == 0x48:
+		addr := ((uint16(c[1]) << 8) | uint16(c[2])) * 2
+		if c[0] == 0x48 {
+			addr++
+		}
+		s.pageBuffer[addr] = c[3]
+
+	case c[0] == 0x4c:
+		return s.writePage(((uint16(c[1]) << 8) | uint16(c[2])) * 2)
+
+	case c[0] == 0xa0:
+		return s.target.ReadEEPROM((uint16(c[1])<<8)|uint16(c[2]), data[3:4])
+
+	case c[0] == 0xc0:
+		return s.target.WriteEEPROM((uint16(c[1])<<8)|uint16(c[2]), c[3:4])
+	}
+
+	return nil
+}
+
+// writePage programs the page buffer like the real flash: only bits that are
+// set can be cleared, erasing requires a chip erase.
+func (s *FakeSpi) writePage(addr uint16) error {
+	mcu, err := s.mcu()
+	if err != nil {
+		return err
+	}
+
+	size := mcu.FlashPageSize()
+	start := addr - (addr % size)
+	page := make([]byte, size)
+	if err := s.target.ReadFlash(start, page); err != nil {
+		return err
+	}
+	for k, v := range s.pageBuffer {
+		page[k%size] &= v
+	}
+	s.pageBuffer = map[uint16]byte{}
+
+	return s.target.WriteFlashPage(start, page)
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/dwtk/dwtk/debugwire/adapters/dwtkice"
+	"github.com/dwtk/dwtk/internal/usbserial"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	RootCmd.AddCommand(AdaptersCmd)
+}
+
+var AdaptersCmd = &cobra.Command{
+	Use:   "adapters",
+	Short: "list attached adapters and exit",
+	Long: `This command lists attached dwtk-ice devices and candidate serial ports, and exits.
+
+When more than one adapter is attached, one can be selected with the 'dwtk-ice'
+and 'serial-port' arguments, using the serial number or device, a glob pattern
+(e.g. 'a1b2*' or '/dev/ttyUSB*') or an USB port path (e.g. 'usb:1-1.2').`,
+	Args:              cobra.NoArgs,
+	PersistentPreRunE: noAdapterPreRunE,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ices, err := dwtkice.List()
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("dwtk-ice devices:\n")
+		if len(ices) == 0 {
+			cmd.Printf("    (none)\n")
+		}
+		for _, ice := range ices {
+			if ice.Err != nil {
+				cmd.Printf("    %s (usb:%s): %s\n", ice.Serial, ice.UsbPath, ice.Err)
+				continue
+			}
+			spi := "no"
+			if ice.Spi {
+				spi = "yes"
+			}
+			cmd.Printf("    %s (usb:%s): firmware %s, SPI ISP: %s\n", ice.Serial, ice.UsbPath, ice.Version, spi)
+		}
+
+		ports, err := usbserial.ListPorts()
+		if err != nil {
+			return err
+		}
+		allow, err := usbserial.LoadAllowList()
+		if err != nil {
+			return err
+		}
+
+		allowPath := usbserial.AllowListPath()
+		if _, err := os.Stat(allowPath); err != nil {
+			allowPath = "built-in, " + allowPath + " not found"
+		}
+		cmd.Printf("\nSerial ports (allow list: %s):\n", allowPath)
+		if len(ports) == 0 {
+			cmd.Printf("    (none)\n")
+		}
+		for _, port := range ports {
+			allowed := "ignored by auto-detection, no allow list rule matches it"
+			if allow.Allows(port) {
+				allowed = "allowed"
+			}
+			cmd.Printf("    %s (usb:%s): %04x:%04x, %s\n", port.Device, port.UsbPath, port.Vid, port.Pid, allowed)
+			if port.ById != "" {
+				cmd.Printf("        %s\n", port.ById)
+			}
+			cmd.Printf("        manufacturer: %q, product: %q, serial: %q\n", port.Manufacturer, port.Product, port.Serial)
+		}
+		return nil
+	},
+}
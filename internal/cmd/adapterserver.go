@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"net"
+
+	"github.com/dwtk/dwtk/debugwire/adapters"
+	"github.com/spf13/cobra"
+)
+
+var (
+	listen string
+)
+
+func init() {
+	AdapterServerCmd.PersistentFlags().StringVarP(
+		&listen,
+		"listen",
+		"l",
+		"localhost:4242",
+		"listen on [host]:port. the adapter is not authenticated, only listen on all interfaces (e.g. :4242) on trusted networks",
+	)
+
+	RootCmd.AddCommand(AdapterServerCmd)
+}
+
+var AdapterServerCmd = &cobra.Command{
+	Use:   "adapter-server",
+	Short: "serve local adapter to remote dwtk instances",
+	Long: `This command serves the local adapter over TCP, until interrupted. Remote dwtk
+instances can use it with the 'remote' argument. The adapter is opened for each
+client, and clients are served one at a time.`,
+	Args:              cobra.NoArgs,
+	PersistentPreRunE: noAdapterPreRunE,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opts, err := adapterOptions()
+		if err != nil {
+			return err
+		}
+
+		l, err := net.Listen("tcp", listen)
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf(" * Serving adapter at %s\n", l.Addr())
+		return adapters.Serve(interruptContext(), l, func() (adapters.Adapter, error) {
+			return adapters.New(opts)
+		})
+	},
+}
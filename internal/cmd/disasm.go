@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+	"github.com/dwtk/dwtk/firmware"
+	"github.com/spf13/cobra"
+)
+
+var (
+	elfDisasm string
+)
+
+func init() {
+	DisasmCmd.PersistentFlags().StringVarP(
+		&elfDisasm,
+		"elf",
+		"e",
+		"",
+		"ELF file to read symbols from",
+	)
+
+	RootCmd.AddCommand(DisasmCmd)
+}
+
+var DisasmCmd = &cobra.Command{
+	Use:   "disasm [START [END]]",
+	Short: "disassemble target MCU's flash and exit",
+	Long: `This command disassembles target MCU's flash, from START to END (byte addresses,
+defaults to the whole flash), and exits.
+
+Instructions not available for the target MCU are shown as data words. If an ELF
+file is provided, its symbols are used to annotate the disassembly.`,
+	Args:    cobra.RangeArgs(0, 2),
+	PreRunE: requireCapabilities(common.CapMemory),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := interruptContext()
+
+		start, end := uint16(0), dw.MCU.FlashSize()
+		for i, arg := range args {
+			v, err := strconv.ParseUint(arg, 0, 17)
+			if err != nil {
+				return err
+			}
+			if v%2 != 0 || v > uint64(dw.MCU.FlashSize()) {
+				return fmt.Errorf("invalid address, must be even and inside flash space: %s", arg)
+			}
+			if i == 0 {
+				start = uint16(v)
+			} else {
+				end = uint16(v)
+			}
+		}
+		if start >= end {
+			return fmt.Errorf("start address must be lower than end address")
+		}
+
+		symbols := avr.Symbols{}
+		if elfDisasm != "" {
+			s, err := firmware.SymbolsFromFile(elfDisasm)
+			if err != nil {
+				return err
+			}
+			symbols = s
+		}
+
+		// read full pages, including the word after the end, that may be part
+		// of the last instruction.
+		page := dw.MCU.FlashPageSize()
+		pstart := start - start%page
+		pend := uint32(end) + 2
+		if pend > uint32(dw.MCU.FlashSize()) {
+			pend = uint32(dw.MCU.FlashSize())
+		}
+		data := make([]byte, 0, pend-uint32(pstart)+uint32(page))
+		read := make([]byte, page)
+		for a := uint32(pstart); a < pend; a += uint32(page) {
+			if err := dw.ReadFlash(ctx, uint16(a), read); err != nil {
+				return err
+			}
+			data = append(data, read...)
+		}
+		data = data[start-pstart : pend-uint32(pstart)]
+
+		features := common.InstructionSet(dw.MCU)
+		for i, inst := range avr.Disassemble(start, data, features) {
+			if inst.Address >= end {
+				break
+			}
+
+			name, ok := symbols[inst.Address]
+			if !ok && i == 0 {
+				name, ok = symbols.Lookup(inst.Address)
+			}
+			if ok {
+				if i > 0 {
+					cmd.Println()
+				}
+				cmd.Printf("0x%04x <%s>:\n", inst.Address, name)
+			}
+
+			b := []string{}
+			for _, c := range inst.Bytes() {
+				b = append(b, fmt.Sprintf("%02x", c))
+			}
+			line := fmt.Sprintf("  0x%04x:  %-11s  %s", inst.Address, strings.Join(b, " "), inst)
+			if c := inst.Comment(symbols); c != "" {
+				line = fmt.Sprintf("%-48s; %s", line, c)
+			}
+			cmd.Println(line)
+		}
+
+		return nil
+	},
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 	"github.com/dwtk/dwtk/firmware"
 	"github.com/spf13/cobra"
 )
@@ -10,11 +11,14 @@ func init() {
 }
 
 var DumpCmd = &cobra.Command{
-	Use:   "dump FILE",
-	Short: "dump firmware (Intel HEX) from target MCU and exit",
-	Long:  "This command dumps firmware (Intel Hex) from target MCU and exits.",
-	Args:  cobra.ExactArgs(1),
+	Use:     "dump FILE",
+	Short:   "dump firmware (Intel HEX) from target MCU and exit",
+	Long:    "This command dumps firmware (Intel Hex) from target MCU and exits.",
+	Args:    cobra.ExactArgs(1),
+	PreRunE: requireCapabilities(common.CapMemory),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := interruptContext()
+
 		numPages := dw.MCU.FlashSize() / dw.MCU.FlashPageSize()
 
 		read := make([]byte, dw.MCU.FlashPageSize())
@@ -22,7 +26,7 @@ var DumpCmd = &cobra.Command{
 		for i := uint16(0); i < numPages; i++ {
 			addr := i * dw.MCU.FlashPageSize()
 			cmd.Printf("Retrieving page 0x%04x (%d/%d) ...\n", addr, i+1, numPages)
-			if err := dw.ReadFlash(addr, read); err != nil {
+			if err := dw.ReadFlash(ctx, addr, read); err != nil {
 				return err
 			}
 			f = append(f, read...)
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 	"github.com/dwtk/dwtk/internal/hex"
 	"github.com/spf13/cobra"
 )
@@ -10,14 +11,17 @@ func init() {
 }
 
 var DumpEEPROMCmd = &cobra.Command{
-	Use:   "dump-eeprom FILE",
-	Short: "dump data (Intel HEX) from target MCU' EEPROM and exit",
-	Long:  "This command dumps data (Intel Hex) from target MCU's EEPROM and exits.",
-	Args:  cobra.ExactArgs(1),
+	Use:     "dump-eeprom FILE",
+	Short:   "dump data (Intel HEX) from target MCU' EEPROM and exit",
+	Long:    "This command dumps data (Intel Hex) from target MCU's EEPROM and exits.",
+	Args:    cobra.ExactArgs(1),
+	PreRunE: requireCapabilities(common.CapMemory),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := interruptContext()
+
 		read := make([]byte, dw.MCU.EEPROMSize())
 		cmd.Printf("Retrieving 0x%04x bytes from EEPROM ...\n", dw.MCU.EEPROMSize())
-		if err := dw.ReadEEPROM(0, read); err != nil {
+		if err := dw.ReadEEPROM(ctx, 0, read); err != nil {
 			return err
 		}
 		return hex.Dump(args[0], read)
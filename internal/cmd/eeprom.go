@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 	"github.com/dwtk/dwtk/internal/hex"
 	"github.com/spf13/cobra"
 )
@@ -25,18 +26,21 @@ func init() {
 }
 
 var EEPROMCmd = &cobra.Command{
-	Use:   "eeprom FILE",
-	Short: "write data (Intel HEX) to target MCU's EEPROM, verify and exit",
-	Long:  "This command writes data (Intel Hex) to target MCU's EEPROM, verifies and exits.",
-	Args:  cobra.ExactArgs(1),
+	Use:     "eeprom FILE",
+	Short:   "write data (Intel HEX) to target MCU's EEPROM, verify and exit",
+	Long:    "This command writes data (Intel Hex) to target MCU's EEPROM, verifies and exits.",
+	Args:    cobra.ExactArgs(1),
+	PreRunE: requireCapabilities(common.CapMemory),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := interruptContext()
+
 		f, err := hex.Parse(args[0])
 		if err != nil {
 			return err
 		}
 
 		cmd.Printf("Writing 0x%04x bytes to EEPROM ...\n", dw.MCU.EEPROMSize())
-		if err := dw.WriteEEPROM(0, f); err != nil {
+		if err := dw.WriteEEPROM(ctx, 0, f); err != nil {
 			return err
 		}
 
@@ -46,7 +50,7 @@ var EEPROMCmd = &cobra.Command{
 
 		read := make([]byte, len(f))
 		cmd.Printf("Verifying 0x%04x bytes from EEPROM ...\n", dw.MCU.EEPROMSize())
-		if err := dw.ReadEEPROM(0, read); err != nil {
+		if err := dw.ReadEEPROM(ctx, 0, read); err != nil {
 			return err
 		}
 		if !bytes.Equal(f, read) {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 	"github.com/spf13/cobra"
 )
 
@@ -32,11 +33,14 @@ func init() {
 }
 
 var EEPROMBytesCmd = &cobra.Command{
-	Use:   "eeprom-bytes FILE",
-	Short: "write arguments (as bytes) to target MCU's EEPROM, verify and exit",
-	Long:  "This command writes arguments (as bytes) to target MCU's EEPROM, verifies and exits.",
-	Args:  cobra.MinimumNArgs(1),
+	Use:     "eeprom-bytes FILE",
+	Short:   "write arguments (as bytes) to target MCU's EEPROM, verify and exit",
+	Long:    "This command writes arguments (as bytes) to target MCU's EEPROM, verifies and exits.",
+	Args:    cobra.MinimumNArgs(1),
+	PreRunE: requireCapabilities(common.CapMemory),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := interruptContext()
+
 		f := []byte{}
 		for _, arg := range args {
 			b, err := strconv.ParseUint(arg, 0, 8)
@@ -47,7 +51,7 @@ var EEPROMBytesCmd = &cobra.Command{
 		}
 
 		cmd.Printf("Writing 0x%04x bytes to EEPROM, starting from 0x%04x ...\n", len(f), startEEPROMBytes)
-		if err := dw.WriteEEPROM(startEEPROMBytes, f); err != nil {
+		if err := dw.WriteEEPROM(ctx, startEEPROMBytes, f); err != nil {
 			return err
 		}
 
@@ -57,7 +61,7 @@ var EEPROMBytesCmd = &cobra.Command{
 
 		read := make([]byte, len(f))
 		cmd.Printf("Verifying 0x%04x bytes from EEPROM, starting from 0x%04x ...\n", len(f), startEEPROMBytes)
-		if err := dw.ReadEEPROM(startEEPROMBytes, read); err != nil {
+		if err := dw.ReadEEPROM(ctx, startEEPROMBytes, read); err != nil {
 			return err
 		}
 		if !bytes.Equal(f, read) {
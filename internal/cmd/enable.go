@@ -1,6 +1,9 @@
 package cmd
 
 import (
+	"fmt"
+
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 	"github.com/spf13/cobra"
 )
 
@@ -13,6 +16,13 @@ var EnableCmd = &cobra.Command{
 	Short: "enable debugWIRE in target MCU and exit",
 	Long:  "This command enables debugWIRE in target MCU and exits.",
 	Args:  cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		caps := dw.Capabilities()
+		if caps.Has(common.CapDebugWIRE) {
+			return fmt.Errorf("target device is already running on debugWIRE mode")
+		}
+		return caps.Require(common.CapSpiIsp | common.CapFuseWrite)
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		noReset = true
 		return dw.Enable()
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 	"github.com/spf13/cobra"
 )
 
@@ -9,18 +10,21 @@ func init() {
 }
 
 var EraseCmd = &cobra.Command{
-	Use:   "erase",
-	Short: "erase target MCU's flash and exit",
-	Long:  "This command erases target MCU's flash and exits.",
-	Args:  cobra.NoArgs,
+	Use:     "erase",
+	Short:   "erase target MCU's flash and exit",
+	Long:    "This command erases target MCU's flash and exits.",
+	Args:    cobra.NoArgs,
+	PreRunE: requireCapabilities(common.CapMemory),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := interruptContext()
+
 		noReset = true
 
 		numPages := dw.MCU.FlashSize() / uint16(dw.MCU.FlashPageSize())
 		for i := uint16(0); i < numPages; i++ {
 			address := i * dw.MCU.FlashPageSize()
 			cmd.Printf("Erasing page 0x%04x (%d/%d) ...\n", address, i+1, numPages)
-			if err := dw.EraseFlashPage(address); err != nil {
+			if err := dw.EraseFlashPage(ctx, address); err != nil {
 				return err
 			}
 		}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 	"github.com/spf13/cobra"
 )
 
@@ -9,10 +10,11 @@ func init() {
 }
 
 var EraseChipCmd = &cobra.Command{
-	Use:   "erase-chip",
-	Short: "erase target flash, eeprom, lock using SPI command and exit",
-	Long:  "This command erases target flash, eeprom, lock using SPI command and exits.",
-	Args:  cobra.NoArgs,
+	Use:     "erase-chip",
+	Short:   "erase target flash, eeprom, lock using SPI command and exit",
+	Long:    "This command erases target flash, eeprom, lock using SPI command and exits.",
+	Args:    cobra.NoArgs,
+	PreRunE: requireCapabilities(common.CapChipErase),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		noReset = true
 		return dw.ChipErase()
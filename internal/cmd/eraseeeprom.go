@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 	"github.com/spf13/cobra"
 )
 
@@ -9,17 +10,20 @@ func init() {
 }
 
 var EraseEEPROMCmd = &cobra.Command{
-	Use:   "erase-eeprom",
-	Short: "erase target MCU's EEPROM and exit",
-	Long:  "This command erases target MCU's EEPROM and exits.",
-	Args:  cobra.NoArgs,
+	Use:     "erase-eeprom",
+	Short:   "erase target MCU's EEPROM and exit",
+	Long:    "This command erases target MCU's EEPROM and exits.",
+	Args:    cobra.NoArgs,
+	PreRunE: requireCapabilities(common.CapMemory),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := interruptContext()
+
 		f := make([]byte, dw.MCU.EEPROMSize())
 		for i := uint16(0); i < dw.MCU.EEPROMSize(); i++ {
 			f[i] = 0xff
 		}
 
 		cmd.Printf("Erasing 0x%04x bytes from EEPROM ...\n", dw.MCU.EEPROMSize())
-		return dw.WriteEEPROM(0, f)
+		return dw.WriteEEPROM(ctx, 0, f)
 	},
 }
@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 	"github.com/dwtk/dwtk/firmware"
 	"github.com/spf13/cobra"
 )
 
 var (
-	noVerify bool
+	noVerify  bool
+	chipErase bool
 )
 
 func init() {
@@ -20,6 +22,13 @@ func init() {
 		false,
 		"do not verify flashed firmware",
 	)
+	FlashCmd.PersistentFlags().BoolVarP(
+		&chipErase,
+		"chip-erase",
+		"e",
+		false,
+		"erase the whole chip before flashing. required on SPI ISP mode",
+	)
 
 	RootCmd.AddCommand(FlashCmd)
 }
@@ -27,9 +36,20 @@ func init() {
 var FlashCmd = &cobra.Command{
 	Use:   "flash FILE",
 	Short: "flash firmware (ELF or Intel HEX) to target MCU, verify and exit",
-	Long:  "This command flashes firmware (ELF or Intel Hex) to target MCU, verifies and exits.",
-	Args:  cobra.ExactArgs(1),
+	Long: `This command flashes firmware (ELF or Intel Hex) to target MCU, verifies and exits.
+
+On SPI ISP mode flash pages can't be erased individually, and the chip must be
+erased before flashing, by passing --chip-erase. This also erases the EEPROM,
+unless the EESAVE fuse is programmed.
+
+With a STK500v1 bootloader pages are erased by the bootloader itself, and the
+bootloader section can't be flashed.`,
+	Args:    cobra.ExactArgs(1),
+	PreRunE: requireCapabilities(common.CapMemory),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		warnStaleBreakpoints(cmd)
+		ctx := interruptContext()
+
 		f, err := firmware.NewFromFile(args[0], dw.MCU)
 		if err != nil {
 			return err
@@ -37,9 +57,23 @@ var FlashCmd = &cobra.Command{
 
 		pages := f.SplitPages()
 
+		caps := dw.Capabilities()
+		if caps.Has(common.CapSpiIsp) && !chipErase {
+			return fmt.Errorf("flash pages can't be erased individually on SPI ISP mode, pass --chip-erase to erase the whole chip, including the EEPROM unless the EESAVE fuse is programmed")
+		}
+		if chipErase {
+			if err := caps.Require(common.CapChipErase); err != nil {
+				return err
+			}
+			cmd.Println("Erasing chip ...")
+			if err := dw.ChipErase(); err != nil {
+				return err
+			}
+		}
+
 		for i, page := range pages {
 			cmd.Printf("Flashing page 0x%04x (%d/%d) ...\n", page.Address, i+1, len(pages))
-			if err := dw.WriteFlashPage(page.Address, page.Data); err != nil {
+			if err := dw.WriteFlashPage(ctx, page.Address, page.Data); err != nil {
 				return err
 			}
 		}
@@ -51,7 +85,7 @@ var FlashCmd = &cobra.Command{
 		read := make([]byte, dw.MCU.FlashPageSize())
 		for i, page := range pages {
 			cmd.Printf("Verifying page 0x%04x (%d/%d) ...\n", page.Address, i+1, len(pages))
-			if err := dw.ReadFlash(page.Address, read); err != nil {
+			if err := dw.ReadFlash(ctx, page.Address, read); err != nil {
 				return err
 			}
 			if !bytes.Equal(page.Data, read) {
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 	"github.com/spf13/cobra"
 )
 
@@ -46,6 +47,14 @@ var FusesCmd = &cobra.Command{
 	Short: "retrieve or set fuses and lock from target MCU and exit",
 	Long:  "This command retrieves or sets fuses and lock from target MCU and exits.",
 	Args:  cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		for _, f := range []string{"lfuse", "hfuse", "efuse", "lock"} {
+			if cmd.Flags().Changed(f) {
+				return requireCapabilities(common.CapFuseWrite)(cmd, args)
+			}
+		}
+		return nil
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		set := false
 
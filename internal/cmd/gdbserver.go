@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 	"github.com/dwtk/dwtk/gdbserver"
 	"github.com/spf13/cobra"
 )
@@ -34,7 +35,16 @@ var GDBServerCmd = &cobra.Command{
 	Short: "start remote debugging session for GDB",
 	Long:  "This command starts a remote debuggins session for GDB.",
 	Args:  cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		caps := common.CapDebugWIRE
+		if runTimers {
+			caps |= common.CapTimers
+		}
+		return requireCapabilities(caps)(cmd, args)
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		warnStaleBreakpoints(cmd)
+
 		dw.Cache = true
 		dw.Timers = runTimers
 		return gdbserver.ListenAndServe(addr, dw)
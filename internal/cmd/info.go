@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"github.com/dwtk/dwtk/avr"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 	"github.com/spf13/cobra"
 )
 
@@ -18,8 +19,14 @@ var InfoCmd = &cobra.Command{
 		cmd.Print(dw.Info())
 		cmd.Printf("\n")
 
+		cmd.Printf("Capabilities: %s\n", dw.Capabilities())
 		cmd.Printf("Target MCU: %s\n", dw.MCU.Name())
 
+		// bootloaders can't read fuses.
+		if !dw.Capabilities().Has(common.CapDebugWIRE) && !dw.Capabilities().Has(common.CapNativeFuseRead) {
+			return nil
+		}
+
 		f, err := dw.ReadFuses()
 		if err != nil {
 			return err
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	IOCmd.AddCommand(IOGetCmd)
+	IOCmd.AddCommand(IOSetCmd)
+	RootCmd.AddCommand(IOCmd)
+}
+
+var IOCmd = &cobra.Command{
+	Use:   "io",
+	Short: "retrieve or set I/O registers and bitfields from target MCU by name",
+	Long: "This command retrieves or sets I/O registers (e.g. PORTB) and bitfields (e.g. TCCR0B.CS0) " +
+		"from target MCU by name.",
+}
+
+var IOGetCmd = &cobra.Command{
+	Use:     "get NAME[.FIELD]...",
+	Short:   "retrieve I/O registers or bitfields from target MCU and exit",
+	Long:    "This command retrieves I/O registers or bitfields from target MCU, resumes it and exits.",
+	Args:    cobra.MinimumNArgs(1),
+	PreRunE: requireCapabilities(common.CapDebugWIRE),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, arg := range args {
+			r, f, err := common.LookupRegister(dw.MCU, arg)
+			if err != nil {
+				return err
+			}
+			v, err := dw.ReadIO(arg)
+			if err != nil {
+				return err
+			}
+			if f != nil {
+				printBitfield(cmd, r.Name+"."+f.Name, f, v)
+			} else {
+				printRegister(cmd, r, v)
+			}
+		}
+		return resumeTarget()
+	},
+}
+
+var IOSetCmd = &cobra.Command{
+	Use:     "set NAME[.FIELD] VALUE",
+	Short:   "set I/O register or bitfield on target MCU and exit",
+	Long:    "This command sets an I/O register or bitfield on target MCU, resumes it and exits.",
+	Args:    cobra.ExactArgs(2),
+	PreRunE: requireCapabilities(common.CapDebugWIRE),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		v, err := strconv.ParseUint(args[1], 0, 16)
+		if err != nil {
+			return err
+		}
+		if err := dw.WriteIO(args[0], uint16(v)); err != nil {
+			return err
+		}
+		return resumeTarget()
+	},
+}
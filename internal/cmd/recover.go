@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"github.com/dwtk/dwtk/debugwire"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	allAdapters bool
+)
+
+func init() {
+	RecoverCmd.PersistentFlags().BoolVarP(
+		&allAdapters,
+		"all-adapters",
+		"A",
+		false,
+		"also recover software breakpoints written using other adapters",
+	)
+
+	RootCmd.AddCommand(RecoverCmd)
+}
+
+var RecoverCmd = &cobra.Command{
+	Use:   "recover",
+	Short: "restore flash instructions replaced by software breakpoints and exit",
+	Long: `This command restores the flash instructions replaced by software breakpoints
+left by previous debugging sessions that didn't exit cleanly, and exits.
+
+The original instructions are read from the breakpoints journal. Breakpoints are
+only restored if the flash still contains the BREAK instruction.`,
+	Args:    cobra.NoArgs,
+	PreRunE: requireCapabilities(common.CapMemory),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		n, err := dw.RecoverBreakpoints(allAdapters)
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("Restored %d software breakpoints (journal: %s)\n", n, debugwire.JournalPath())
+		return nil
+	},
+}
+
+// warnStaleBreakpoints warns about software breakpoints left in flash by a
+// previous session for the connected target.
+func warnStaleBreakpoints(cmd *cobra.Command) {
+	n, err := dw.StaleBreakpoints()
+	if err != nil {
+		cmd.PrintErrf("Warning: failed to read software breakpoints journal: %s\n", err)
+		return
+	}
+	if n > 0 {
+		cmd.PrintErrf("Warning: %d software breakpoints were left in flash by a previous session, run `dwtk recover` to restore the original instructions\n", n)
+	}
+}
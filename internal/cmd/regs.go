@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	RootCmd.AddCommand(RegsCmd)
+}
+
+var RegsCmd = &cobra.Command{
+	Use:   "regs [NAME...]",
+	Short: "retrieve I/O registers from target MCU, decode them and exit",
+	Long: "This command retrieves I/O registers from target MCU (all of them, or the ones named by arguments), " +
+		"decodes their bitfields and exits. The target is resumed where it was halted, instead of being reset. " +
+		"Registers that have side effects when read (e.g. UDR0) are skipped, unless named by arguments.",
+	PreRunE: requireCapabilities(common.CapDebugWIRE),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		regs := []*common.Register{}
+		if len(args) == 0 {
+			regs = common.Registers(dw.MCU)
+			if len(regs) == 0 {
+				return fmt.Errorf("no I/O registers known for %s", dw.MCU.Name())
+			}
+		}
+		for _, arg := range args {
+			r, _, err := common.LookupRegister(dw.MCU, arg)
+			if err != nil {
+				return err
+			}
+			regs = append(regs, r)
+		}
+
+		for _, r := range regs {
+			if r.ReadSensitive && len(args) == 0 {
+				cmd.Printf("%-8s 0x%04x = (not read, has side effects)  %s\n", r.Name, r.Address, r.Caption)
+				continue
+			}
+			v, err := dw.ReadIO(r.Name)
+			if err != nil {
+				return err
+			}
+			printRegister(cmd, r, v)
+		}
+		return resumeTarget()
+	},
+}
+
+func formatValue(v uint16, size byte) string {
+	if size > 1 {
+		return fmt.Sprintf("0x%04x", v)
+	}
+	return fmt.Sprintf("0x%02x", v)
+}
+
+func printRegister(cmd *cobra.Command, r *common.Register, v uint16) {
+	cmd.Printf("%-8s 0x%04x = %s  %s\n", r.Name, r.Address, formatValue(v, r.Size), r.Caption)
+	for _, f := range r.Fields {
+		printBitfield(cmd, "    "+f.Name, f, f.Get(v))
+	}
+}
+
+func printBitfield(cmd *cobra.Command, name string, f *common.Bitfield, v uint16) {
+	line := fmt.Sprintf("%-12s = 0x%x", name, v)
+	if d := f.Describe(v); d != "" {
+		line += fmt.Sprintf(" (%s)", d)
+	}
+	cmd.Println(line)
+}
+
+// resumeTarget resumes the target from where it was halted, instead of
+// resetting it, so that it keeps running with the inspected or written
+// registers.
+func resumeTarget() error {
+	noReset = true
+	return dw.Go()
+}
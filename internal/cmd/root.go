@@ -1,23 +1,38 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"os/signal"
 
 	"github.com/dwtk/dwtk/debugwire"
+	"github.com/dwtk/dwtk/debugwire/adapters"
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 	"github.com/dwtk/dwtk/internal/logger"
 	"github.com/dwtk/dwtk/internal/version"
 	"github.com/spf13/cobra"
+	"golang.org/x/sys/unix"
 )
 
 var (
-	dw      *debugwire.DebugWIRE
-	noReset bool
-
-	dwtkIce    string
-	serialPort string
-	baudrate   uint32
-	frequency  float32
-	debug      bool
+	dw          *debugwire.DebugWIRE
+	noReset     bool
+	restoreMode func() error
+
+	dwtkIce     string
+	serialPort  string
+	baudrate    uint32
+	frequency   float32
+	useStk500   bool
+	useStk500v2 bool
+	useUpdi     bool
+	simSpec     string
+	remote      string
+	record      string
+	replay      string
+	autoMode    bool
+	debug       bool
 )
 
 func init() {
@@ -26,14 +41,14 @@ func init() {
 		"dwtk-ice",
 		"i",
 		"",
-		"dwtk-ice device serial number (e.g. a1b2c3d4) (Default: detect)",
+		"dwtk-ice device serial number, glob or USB path (e.g. a1b2c3d4, 'a1b2*' or usb:1-1.2) (Default: detect)",
 	)
 	RootCmd.PersistentFlags().StringVarP(
 		&serialPort,
 		"serial-port",
 		"s",
 		"",
-		"serial port device (e.g. /dev/ttyUSB0) (Default: detect)",
+		"serial port device, glob or USB path (e.g. /dev/ttyUSB0, '/dev/ttyUSB*' or usb:1-1.3) (Default: detect)",
 	)
 	RootCmd.PersistentFlags().Uint32VarP(
 		&baudrate,
@@ -49,6 +64,54 @@ func init() {
 		0,
 		"target MCU frequency in MHz (e.g. 16) (Default: unset)",
 	)
+	RootCmd.PersistentFlags().BoolVar(
+		&useStk500,
+		"stk500",
+		false,
+		"talk to a STK500v1 serial bootloader (e.g. Optiboot) on the serial port, instead of debugWIRE (Default baudrate: 115200)",
+	)
+	RootCmd.PersistentFlags().BoolVar(
+		&useStk500v2,
+		"stk500v2",
+		false,
+		"talk to a STK500v2 SPI ISP programmer (e.g. AVRISP) on the serial port, instead of debugWIRE (Default baudrate: 115200)",
+	)
+	RootCmd.PersistentFlags().BoolVar(
+		&useUpdi,
+		"updi",
+		false,
+		"talk UPDI to a tinyAVR 0/1/2 or megaAVR 0 target on the serial port, instead of debugWIRE. AVR-Dx targets are not supported (Default baudrate: 115200)",
+	)
+	RootCmd.PersistentFlags().StringVar(
+		&simSpec,
+		"sim",
+		"",
+		"simulate target MCU, optionally preloading firmware (e.g. atmega328p or 'atmega328p firmware.elf')",
+	)
+	RootCmd.PersistentFlags().StringVar(
+		&remote,
+		"remote",
+		"",
+		"use adapter served by `dwtk adapter-server` on remote host (e.g. raspberrypi:4242)",
+	)
+	RootCmd.PersistentFlags().StringVar(
+		&record,
+		"record",
+		"",
+		"record adapter calls to transcript file (e.g. session.dwtrace)",
+	)
+	RootCmd.PersistentFlags().StringVar(
+		&replay,
+		"replay",
+		"",
+		"replay adapter calls from transcript file instead of using hardware (e.g. session.dwtrace)",
+	)
+	RootCmd.PersistentFlags().BoolVar(
+		&autoMode,
+		"auto-mode",
+		false,
+		"switch target between debugWIRE and SPI ISP modes as required by the command, and back",
+	)
 	RootCmd.PersistentFlags().BoolVarP(
 		&debug,
 		"debug",
@@ -73,16 +136,12 @@ var RootCmd = &cobra.Command{
 			logger.EnableDebug()
 		}
 
-		if baudrate != 0 && frequency != 0 {
-			return fmt.Errorf("'frequency' and 'baudrate' arguments are mutually exclusive")
-		}
-
-		if frequency != 0 {
-			baudrate = uint32(frequency * 1000000 / 128)
+		opts, err := adapterOptions()
+		if err != nil {
+			return err
 		}
 
-		var err error
-		dw, err = debugwire.New(dwtkIce, serialPort, baudrate)
+		dw, err = debugwire.New(opts)
 		if err != nil {
 			return err
 		}
@@ -91,15 +150,101 @@ var RootCmd = &cobra.Command{
 			return fmt.Errorf("failed to detect MCU")
 		}
 
+		dw.AutoMode = autoMode
 		noReset = false
 
 		return nil
 	},
 }
 
+func adapterOptions() (*adapters.Options, error) {
+	if baudrate != 0 && frequency != 0 {
+		return nil, fmt.Errorf("'frequency' and 'baudrate' arguments are mutually exclusive")
+	}
+
+	if simSpec != "" && (dwtkIce != "" || serialPort != "") {
+		return nil, fmt.Errorf("'sim' argument is mutually exclusive with 'dwtk-ice' and 'serial-port'")
+	}
+
+	if useStk500 && (serialPort == "" || dwtkIce != "" || simSpec != "" || frequency != 0) {
+		return nil, fmt.Errorf("'stk500' argument requires 'serial-port' argument, and is mutually exclusive with 'dwtk-ice', 'sim' and 'frequency'")
+	}
+
+	if useStk500v2 && (serialPort == "" || dwtkIce != "" || simSpec != "" || frequency != 0 || useStk500) {
+		return nil, fmt.Errorf("'stk500v2' argument requires 'serial-port' argument, and is mutually exclusive with 'dwtk-ice', 'sim', 'frequency' and 'stk500'")
+	}
+
+	if useUpdi && (serialPort == "" || dwtkIce != "" || simSpec != "" || frequency != 0 || useStk500 || useStk500v2) {
+		return nil, fmt.Errorf("'updi' argument requires 'serial-port' argument, and is mutually exclusive with 'dwtk-ice', 'sim', 'frequency', 'stk500' and 'stk500v2'")
+	}
+
+	if remote != "" && (useStk500 || useStk500v2 || useUpdi || simSpec != "" || dwtkIce != "" || serialPort != "" || baudrate != 0 || frequency != 0) {
+		return nil, fmt.Errorf("'remote' argument is mutually exclusive with adapter selection arguments")
+	}
+
+	if replay != "" && (record != "" || remote != "" || simSpec != "" || dwtkIce != "" || serialPort != "") {
+		return nil, fmt.Errorf("'replay' argument is mutually exclusive with adapter selection and 'record' arguments")
+	}
+
+	if frequency != 0 {
+		baudrate = uint32(frequency * 1000000 / 128)
+	}
+
+	return &adapters.Options{
+		DwtkIce:    dwtkIce,
+		SerialPort: serialPort,
+		Baudrate:   baudrate,
+		Stk500:     useStk500,
+		Stk500v2:   useStk500v2,
+		Updi:       useUpdi,
+		Sim:        simSpec,
+		Remote:     remote,
+		Record:     record,
+		Replay:     replay,
+	}, nil
+}
+
+// noAdapterPreRunE replaces the root PersistentPreRunE for commands that
+// must not open an adapter.
+func noAdapterPreRunE(cmd *cobra.Command, args []string) error {
+	if debug {
+		logger.EnableDebug()
+	}
+	return nil
+}
+
+func requireCapabilities(caps common.Capabilities) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		restore, err := dw.RequireMode(caps, cmd.OutOrStdout())
+		if err != nil {
+			return err
+		}
+		restoreMode = restore
+		return nil
+	}
+}
+
+// interruptContext returns a context that is canceled by SIGINT or SIGTERM, for
+// long operations to stop cleanly instead of leaving the target half written.
+func interruptContext() context.Context {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, unix.SIGINT, unix.SIGTERM)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-sig
+		cancel()
+	}()
+	return ctx
+}
+
 func Close() error {
 	if dw != nil {
 		defer dw.Close()
+		if restoreMode != nil {
+			if err := restoreMode(); err != nil {
+				return err
+			}
+		}
 		if !noReset {
 			if err := dw.ResetAndGo(); err != nil {
 				return err
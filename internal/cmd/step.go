@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	stepOver bool
+	stepOut  bool
+)
+
+func init() {
+	StepCmd.PersistentFlags().BoolVar(
+		&stepOver,
+		"over",
+		false,
+		"run called functions until they return",
+	)
+	StepCmd.PersistentFlags().BoolVar(
+		&stepOut,
+		"out",
+		false,
+		"step until the current function returns",
+	)
+	RootCmd.AddCommand(StepCmd)
+	RootCmd.AddCommand(RunToCmd)
+}
+
+var StepCmd = &cobra.Command{
+	Use:   "step",
+	Short: "step target MCU, leave it halted and exit",
+	Long: "This command executes a single instruction on target MCU, prints the program counter, " +
+		"and exits with the target halted. With --over, called functions run until they return. " +
+		"With --out, the target is stepped until the current function returns to its caller. " +
+		"The target also halts on breakpoints still written to flash.",
+	Args:    cobra.NoArgs,
+	PreRunE: requireCapabilities(common.CapDebugWIRE),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if stepOver && stepOut {
+			return fmt.Errorf("'over' and 'out' arguments are mutually exclusive")
+		}
+
+		var err error
+		switch {
+		case stepOver:
+			err = dw.StepOver(interruptContext())
+		case stepOut:
+			err = dw.StepOut(interruptContext())
+		default:
+			err = dw.Step()
+		}
+		if err != nil {
+			return err
+		}
+		return printHalted(cmd)
+	},
+}
+
+var RunToCmd = &cobra.Command{
+	Use:   "run-to ADDR",
+	Short: "run target MCU to address, leave it halted and exit",
+	Long: "This command resumes the target MCU until it reaches the given byte address (e.g. 0x1a4), " +
+		"prints the program counter, and exits with the target halted. The target also halts on " +
+		"breakpoints still written to flash.",
+	Args:    cobra.ExactArgs(1),
+	PreRunE: requireCapabilities(common.CapDebugWIRE),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		addr, err := strconv.ParseUint(args[0], 0, 16)
+		if err != nil {
+			return err
+		}
+		if err := dw.RunTo(interruptContext(), uint16(addr)); err != nil {
+			return err
+		}
+		return printHalted(cmd)
+	},
+}
+
+// printHalted prints the program counter of the halted target, that is kept
+// halted when exiting.
+func printHalted(cmd *cobra.Command) error {
+	noReset = true
+	pc, err := dw.GetPC()
+	if err != nil {
+		return err
+	}
+	cmd.Printf("Halted at 0x%04x\n", pc)
+	return nil
+}
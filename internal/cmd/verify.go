@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/dwtk/dwtk/debugwire/adapters/common"
 	"github.com/dwtk/dwtk/firmware"
 	"github.com/spf13/cobra"
 )
@@ -13,11 +14,15 @@ func init() {
 }
 
 var VerifyCmd = &cobra.Command{
-	Use:   "verify FILE",
-	Short: "verify firmware (ELF or Intel HEX) against target MCU's content and exit",
-	Long:  "This command verifies firmware (ELF or Intel HEX) against target MCU's content and exits.",
-	Args:  cobra.ExactArgs(1),
+	Use:     "verify FILE",
+	Short:   "verify firmware (ELF or Intel HEX) against target MCU's content and exit",
+	Long:    "This command verifies firmware (ELF or Intel HEX) against target MCU's content and exits.",
+	Args:    cobra.ExactArgs(1),
+	PreRunE: requireCapabilities(common.CapMemory),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		warnStaleBreakpoints(cmd)
+		ctx := interruptContext()
+
 		f, err := firmware.NewFromFile(args[0], dw.MCU)
 		if err != nil {
 			return err
@@ -28,7 +33,7 @@ var VerifyCmd = &cobra.Command{
 		read := make([]byte, dw.MCU.FlashPageSize())
 		for i, page := range pages {
 			cmd.Printf("Verifying page 0x%04x (%d/%d) ...\n", page.Address, i+1, len(pages))
-			if err := dw.ReadFlash(page.Address, read); err != nil {
+			if err := dw.ReadFlash(ctx, page.Address, read); err != nil {
 				return err
 			}
 			if !bytes.Equal(page.Data, read) {
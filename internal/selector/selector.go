@@ -0,0 +1,38 @@
+package selector
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// rules are either a plain name (e.g. a serial number or a device path), a
+// glob pattern matched against the name, or "usb:" followed by a glob
+// pattern matched against the USB port path (e.g. usb:1-1.2).
+const usbPrefix = "usb:"
+
+func IsPattern(rule string) bool {
+	return strings.HasPrefix(rule, usbPrefix) || strings.ContainsAny(rule, "*?[")
+}
+
+func Match(rule string, name string, usbPath string) (bool, error) {
+	if rule == "" {
+		return true, nil
+	}
+
+	pattern := rule
+	value := name
+	if strings.HasPrefix(rule, usbPrefix) {
+		pattern = rule[len(usbPrefix):]
+		value = usbPath
+		if value == "" {
+			return false, nil
+		}
+	}
+
+	rv, err := path.Match(pattern, value)
+	if err != nil {
+		return false, fmt.Errorf("selector: invalid rule: %s: %s", rule, err)
+	}
+	return rv, nil
+}
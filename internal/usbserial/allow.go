@@ -0,0 +1,120 @@
+package usbserial
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// AllowRule matches ports by attributes: vid, pid, manufacturer, product,
+// serial, usb (port path) and device. values are glob patterns, and all of
+// them must match.
+type AllowRule map[string]string
+
+// AllowList selects which ports are considered during auto-detection. It is
+// loaded from a file with one rule per line, e.g.:
+//
+//	# FTDI cables from our bench
+//	vid=0403 pid=6001 serial=A10*
+//	manufacturer=wch.cn
+type AllowList []AllowRule
+
+// DefaultAllowList covers the USB-serial converters usually used as
+// debugWIRE adapters: FTDI, WCH (CH340, CH341, CH9102, ...), CP210x, PL2303
+// and CDC-ACM devices (e.g. Arduino boards used as adapters). Values are
+// lower case, as ports are compared in lower case.
+var DefaultAllowList = AllowList{
+	{"vid": "0403"},
+	{"vid": "1a86"},
+	{"vid": "10c4", "pid": "ea60"},
+	{"vid": "067b", "pid": "2303"},
+	{"device": "/dev/ttyacm*"},
+}
+
+var allowKeys = map[string]func(p *Port) string{
+	"vid":          func(p *Port) string { return fmt.Sprintf("%04x", p.Vid) },
+	"pid":          func(p *Port) string { return fmt.Sprintf("%04x", p.Pid) },
+	"manufacturer": func(p *Port) string { return p.Manufacturer },
+	"product":      func(p *Port) string { return p.Product },
+	"serial":       func(p *Port) string { return p.Serial },
+	"usb":          func(p *Port) string { return p.UsbPath },
+	"device":       func(p *Port) string { return p.Device },
+}
+
+func AllowListPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "dwtk", "serial-ports.conf")
+}
+
+// LoadAllowList loads the allow list from AllowListPath, or returns
+// DefaultAllowList if the file does not exists.
+func LoadAllowList() (AllowList, error) {
+	p := AllowListPath()
+	if p == "" {
+		return DefaultAllowList, nil
+	}
+
+	fp, err := os.Open(p)
+	if os.IsNotExist(err) {
+		return DefaultAllowList, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer fp.Close()
+
+	rv := AllowList{}
+	line := 0
+	scanner := bufio.NewScanner(fp)
+	for scanner.Scan() {
+		line++
+		l := strings.TrimSpace(scanner.Text())
+		if l == "" || strings.HasPrefix(l, "#") {
+			continue
+		}
+
+		rule := AllowRule{}
+		for _, f := range strings.Fields(l) {
+			kv := strings.SplitN(f, "=", 2)
+			if len(kv) != 2 {
+				return nil, fmt.Errorf("usbserial: %s:%d: invalid field, expected key=value: %s", p, line, f)
+			}
+			if _, ok := allowKeys[kv[0]]; !ok {
+				return nil, fmt.Errorf("usbserial: %s:%d: invalid key: %s", p, line, kv[0])
+			}
+			if _, err := path.Match(kv[1], ""); err != nil {
+				return nil, fmt.Errorf("usbserial: %s:%d: invalid pattern: %s", p, line, kv[1])
+			}
+			rule[kv[0]] = strings.ToLower(kv[1])
+		}
+		rv = append(rv, rule)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+func (r AllowRule) Matches(p *Port) bool {
+	for k, v := range r {
+		if m, _ := path.Match(v, strings.ToLower(allowKeys[k](p))); !m {
+			return false
+		}
+	}
+	return true
+}
+
+func (l AllowList) Allows(p *Port) bool {
+	for _, r := range l {
+		if r.Matches(p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package usbserial
+
+import (
+	"testing"
+)
+
+func TestDefaultAllowList(t *testing.T) {
+	for _, tc := range []struct {
+		port    *Port
+		allowed bool
+	}{
+		{&Port{Device: "/dev/ttyUSB0", Vid: 0x0403, Pid: 0x6001}, true},
+		{&Port{Device: "/dev/ttyUSB0", Vid: 0x1a86, Pid: 0x7523}, true},
+		{&Port{Device: "/dev/ttyACM0", Vid: 0x1a86, Pid: 0x55d4}, true},
+		{&Port{Device: "/dev/ttyUSB0", Vid: 0x10c4, Pid: 0xea60}, true},
+		{&Port{Device: "/dev/ttyUSB0", Vid: 0x067b, Pid: 0x2303}, true},
+		{&Port{Device: "/dev/ttyACM0", Vid: 0x2341, Pid: 0x0043}, true},
+		{&Port{Device: "/dev/ttyUSB0", Vid: 0x1234, Pid: 0x5678}, false},
+		{&Port{Device: "/dev/ttyS0"}, false},
+	} {
+		if allowed := DefaultAllowList.Allows(tc.port); allowed != tc.allowed {
+			t.Errorf("%s %04x:%04x: allowed=%v", tc.port.Device, tc.port.Vid, tc.port.Pid, allowed)
+		}
+	}
+}
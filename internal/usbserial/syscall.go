@@ -5,7 +5,6 @@ package usbserial
 import (
 	"bytes"
 	"fmt"
-	"path/filepath"
 	"syscall"
 	"time"
 	"unsafe"
@@ -14,16 +13,8 @@ import (
 	"golang.org/x/sys/unix"
 )
 
-func ListDevices() ([]string, error) {
-	m, err := filepath.Glob("/dev/ttyUSB*")
-	if err != nil {
-		return nil, err
-	}
-	if m == nil {
-		return []string{}, nil
-	}
-	return m, nil
-}
+// even parity and 2 stop bits.
+const updiCflag = unix.PARENB | unix.CSTOPB
 
 func ioctl(fd int, req uint, arg uintptr) error {
 	for {
@@ -37,26 +28,13 @@ func ioctl(fd int, req uint, arg uintptr) error {
 	}
 }
 
-func open(portDevice string, baudrate uint32) (int, error) {
+func open(portDevice string, baudrate uint32, cflag uint32) (int, error) {
 	fd, err := unix.Open(portDevice, unix.O_RDWR, 0600)
 	if err != nil {
 		return -1, err
 	}
 
-	cfg := &unix.Termios{
-		Iflag:  unix.IGNPAR,
-		Cflag:  unix.BOTHER | unix.CS8 | unix.CLOCAL,
-		Oflag:  0,
-		Lflag:  0,
-		Ispeed: baudrate,
-		Ospeed: baudrate,
-	}
-	cfg.Cc[unix.VMIN] = 0
-
-	// as we always receive the echo from our own transmission, 200ms is enough, and bigger than our maximum frame time
-	cfg.Cc[unix.VTIME] = 2
-
-	if err := ioctl(fd, unix.TCSETS2, uintptr(unsafe.Pointer(cfg))); err != nil {
+	if err := configure(fd, baudrate, cflag); err != nil {
 		unix.Close(fd)
 		return -1, err
 	}
@@ -71,6 +49,23 @@ func open(portDevice string, baudrate uint32) (int, error) {
 	return fd, nil
 }
 
+func configure(fd int, baudrate uint32, cflag uint32) error {
+	cfg := &unix.Termios{
+		Iflag:  unix.IGNPAR,
+		Cflag:  unix.BOTHER | unix.CS8 | unix.CLOCAL | cflag,
+		Oflag:  0,
+		Lflag:  0,
+		Ispeed: baudrate,
+		Ospeed: baudrate,
+	}
+	cfg.Cc[unix.VMIN] = 0
+
+	// as we always receive the echo from our own transmission, 200ms is enough, and bigger than our maximum frame time
+	cfg.Cc[unix.VTIME] = 2
+
+	return ioctl(fd, unix.TCSETS2, uintptr(unsafe.Pointer(cfg)))
+}
+
 func _close(fd int) error {
 	return unix.Close(fd)
 }
@@ -87,7 +82,7 @@ func read(fd int, p []byte) error {
 			return err
 		}
 		if c == 0 {
-			return fmt.Errorf("usbserial: read: got unexpected EOF")
+			return ErrTimeout
 		}
 		n += c
 	}
@@ -97,7 +92,7 @@ func read(fd int, p []byte) error {
 	return nil
 }
 
-func write(fd int, p []byte) error {
+func write(fd int, p []byte, echo bool) error {
 	n := 0
 	for n < len(p) {
 		c, err := unix.Write(fd, p[n:])
@@ -113,18 +108,60 @@ func write(fd int, p []byte) error {
 		logger.Debug.Printf(">>> 0x%02x", p[i])
 	}
 
+	if !echo {
+		return nil
+	}
+
 	e := make([]byte, len(p))
 	if err := read(fd, e); err != nil {
 		return err
 	}
 
 	if bytes.Compare(p, e) != 0 {
-		return fmt.Errorf("usbserial: got unexpected byte echoed back")
+		return ErrEcho
 	}
 
 	return nil
 }
 
+func setDTRRTS(fd int, v bool) error {
+	bits := unix.TIOCM_DTR | unix.TIOCM_RTS
+	if v {
+		return ioctl(fd, unix.TIOCMBIS, uintptr(unsafe.Pointer(&bits)))
+	}
+	return ioctl(fd, unix.TIOCMBIC, uintptr(unsafe.Pointer(&bits)))
+}
+
+// Line controls the serial line signals that aren't data: breaks and modem
+// lines.
+type Line interface {
+	SendBreak(baudrate uint32) error
+	SetDTRRTS(v bool) error
+}
+
+// EmulatedLine returns the line of a device whose line signals are emulated,
+// or nil. It is only set by the usbserialtest package, for the ptys it opens.
+var EmulatedLine func(device string, fd int) Line
+
+func newLine(device string, fd int) Line {
+	if EmulatedLine != nil {
+		if l := EmulatedLine(device, fd); l != nil {
+			return l
+		}
+	}
+	return ttyLine(fd)
+}
+
+type ttyLine int
+
+func (l ttyLine) SendBreak(baudrate uint32) error {
+	return sendBreak(int(l), baudrate)
+}
+
+func (l ttyLine) SetDTRRTS(v bool) error {
+	return setDTRRTS(int(l), v)
+}
+
 func sendBreak(fd int, baudrate uint32) error {
 	logger.Debug.Print("> break")
 
@@ -0,0 +1,113 @@
+// +build linux
+
+package usbserial
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Port struct {
+	Device       string
+	ById         string
+	UsbPath      string
+	Vid          uint16
+	Pid          uint16
+	Manufacturer string
+	Product      string
+	Serial       string
+}
+
+func sysfsRead(dir string, entry string) string {
+	rv, err := ioutil.ReadFile(filepath.Join(dir, entry))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(rv))
+}
+
+func sysfsReadHex(dir string, entry string) uint16 {
+	rv, err := strconv.ParseUint(sysfsRead(dir, entry), 16, 16)
+	if err != nil {
+		return 0
+	}
+	return uint16(rv)
+}
+
+// ListPorts returns the serial ports provided by USB devices, including
+// USB-serial converters (ttyUSB) and CDC-ACM devices (ttyACM).
+func ListPorts() ([]*Port, error) {
+	ttys, err := filepath.Glob("/sys/class/tty/*")
+	if err != nil {
+		return nil, err
+	}
+
+	byId := map[string]string{}
+	links, err := filepath.Glob("/dev/serial/by-id/*")
+	if err != nil {
+		return nil, err
+	}
+	for _, link := range links {
+		if dev, err := filepath.EvalSymlinks(link); err == nil {
+			byId[dev] = link
+		}
+	}
+
+	rv := []*Port{}
+	for _, tty := range ttys {
+		dir, err := filepath.EvalSymlinks(filepath.Join(tty, "device"))
+		if err != nil {
+			continue
+		}
+
+		// the USB device is the first parent with a vendor id.
+		for ; dir != "/" && dir != "."; dir = filepath.Dir(dir) {
+			if _, err := os.Stat(filepath.Join(dir, "idVendor")); err == nil {
+				break
+			}
+		}
+		if dir == "/" || dir == "." {
+			continue
+		}
+
+		dev := filepath.Join("/dev", filepath.Base(tty))
+		rv = append(rv, &Port{
+			Device:       dev,
+			ById:         byId[dev],
+			UsbPath:      filepath.Base(dir),
+			Vid:          sysfsReadHex(dir, "idVendor"),
+			Pid:          sysfsReadHex(dir, "idProduct"),
+			Manufacturer: sysfsRead(dir, "manufacturer"),
+			Product:      sysfsRead(dir, "product"),
+			Serial:       sysfsRead(dir, "serial"),
+		})
+	}
+
+	sort.Slice(rv, func(i int, j int) bool {
+		return rv[i].Device < rv[j].Device
+	})
+	return rv, nil
+}
+
+// FindPort returns the USB serial port for the device path, that may be a
+// symlink (e.g. from /dev/serial/by-id), or nil if not found.
+func FindPort(device string) (*Port, error) {
+	dev, err := filepath.EvalSymlinks(device)
+	if err != nil {
+		return nil, err
+	}
+	ports, err := ListPorts()
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range ports {
+		if p.Device == dev {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
@@ -2,20 +2,48 @@ package usbserial
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	"github.com/dwtk/dwtk/internal/wait"
 )
 
+var (
+	// reads time out when the target does not answer, what usually means that
+	// its baudrate changed.
+	ErrTimeout = errors.New("usbserial: read: got unexpected EOF")
+	ErrEcho    = errors.New("usbserial: got unexpected byte echoed back")
+)
+
 type UsbSerial struct {
 	baudrate uint32
 	fd       int
 	mutex    *sync.RWMutex
 	buf      []byte
+	line     Line
+	echo     bool
+	cflag    uint32
 }
 
+// Open opens a one-wire serial port, where everything written is echoed back
+// and checked.
 func Open(device string, baudrate uint32) (*UsbSerial, error) {
-	fd, err := open(device, baudrate)
+	return openPort(device, baudrate, true, 0)
+}
+
+// OpenRaw opens a regular serial port, without echo.
+func OpenRaw(device string, baudrate uint32) (*UsbSerial, error) {
+	return openPort(device, baudrate, false, 0)
+}
+
+// OpenUpdi opens a one-wire serial port with even parity and 2 stop bits, as
+// required by UPDI.
+func OpenUpdi(device string, baudrate uint32) (*UsbSerial, error) {
+	return openPort(device, baudrate, true, updiCflag)
+}
+
+func openPort(device string, baudrate uint32, echo bool, cflag uint32) (*UsbSerial, error) {
+	fd, err := open(device, baudrate, cflag)
 	if err != nil {
 		return nil, err
 	}
@@ -25,6 +53,9 @@ func Open(device string, baudrate uint32) (*UsbSerial, error) {
 		fd:       fd,
 		mutex:    &sync.RWMutex{},
 		buf:      []byte{},
+		line:     newLine(device, fd),
+		echo:     echo,
+		cflag:    cflag,
 	}, nil
 }
 
@@ -32,7 +63,7 @@ func (u *UsbSerial) Commit() error {
 	u.mutex.RLock()
 	defer u.mutex.RUnlock()
 
-	err := write(u.fd, u.buf)
+	err := write(u.fd, u.buf, u.echo)
 	u.buf = []byte{}
 	return err
 }
@@ -85,12 +116,44 @@ func (u *UsbSerial) Write(p []byte) error {
 	return nil
 }
 
+// Pending returns a copy of the data written but not committed yet.
+func (u *UsbSerial) Pending() []byte {
+	u.mutex.RLock()
+	defer u.mutex.RUnlock()
+
+	return append([]byte{}, u.buf...)
+}
+
+// SetBaudrate changes the baudrate of the open port, that is much faster than
+// reopening it.
+func (u *UsbSerial) SetBaudrate(baudrate uint32) error {
+	if err := u.Commit(); err != nil {
+		return err
+	}
+
+	if err := configure(u.fd, baudrate, u.cflag); err != nil {
+		return err
+	}
+	u.baudrate = baudrate
+	return flush(u.fd)
+}
+
+// SetDTRRTS sets both DTR and RTS lines, that are usually connected to the
+// target reset pin through a capacitor.
+func (u *UsbSerial) SetDTRRTS(v bool) error {
+	if err := u.Commit(); err != nil {
+		return err
+	}
+
+	return u.line.SetDTRRTS(v)
+}
+
 func (u *UsbSerial) SendBreak() error {
 	if err := u.Commit(); err != nil {
 		return err
 	}
 
-	return sendBreak(u.fd, u.baudrate)
+	return u.line.SendBreak(u.baudrate)
 }
 
 func (u *UsbSerial) RecvBreak() (byte, error) {
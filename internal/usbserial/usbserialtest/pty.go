@@ -0,0 +1,179 @@
+// +build linux
+
+// Package usbserialtest serves simulated targets on pseudo-terminals, that
+// are opened by the usbserial package with emulated line signals.
+package usbserialtest
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dwtk/dwtk/internal/logger"
+	"github.com/dwtk/dwtk/internal/usbserial"
+	"golang.org/x/sys/unix"
+)
+
+// pseudo-terminals can't transmit breaks, so we send this byte instead.
+// targets emulated on a pty must handle it as a break when waiting for a
+// command. it matches sim.WireBreak.
+const PtyBreak = byte(0x00)
+
+type Pty struct {
+	master int
+	slave  int
+	name   string
+}
+
+// ptys opened by this process. their slaves are opened with a ptyLine,
+// instead of the terminal line signals, that ptys don't transmit.
+var (
+	ptys      = map[string]bool{}
+	ptysMutex = &sync.Mutex{}
+)
+
+func init() {
+	usbserial.EmulatedLine = emulatedLine
+}
+
+func emulatedLine(device string, fd int) usbserial.Line {
+	ptysMutex.Lock()
+	defer ptysMutex.Unlock()
+
+	if ptys[device] {
+		return ptyLine(fd)
+	}
+	return nil
+}
+
+// ptyLine emulates the line signals on the slave side of a pty, for
+// simulated targets.
+type ptyLine int
+
+func (l ptyLine) SendBreak(baudrate uint32) error {
+	logger.Debug.Print("> break (pty)")
+	_, err := unix.Write(int(l), []byte{PtyBreak})
+	return err
+}
+
+// pseudo-terminals have no modem lines, and simulated targets are reset by
+// the protocol instead.
+func (l ptyLine) SetDTRRTS(v bool) error {
+	return nil
+}
+
+func OpenPty() (*Pty, error) {
+	master, err := unix.Open("/dev/ptmx", unix.O_RDWR|unix.O_NOCTTY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := unix.IoctlSetPointerInt(master, unix.TIOCSPTLCK, 0); err != nil {
+		unix.Close(master)
+		return nil, err
+	}
+
+	n, err := unix.IoctlGetUint32(master, unix.TIOCGPTN)
+	if err != nil {
+		unix.Close(master)
+		return nil, err
+	}
+	name := fmt.Sprintf("/dev/pts/%d", n)
+
+	// we keep the slave open ourselves, otherwise the master would get EIO
+	// every time the host closes the port (e.g. during baudrate detection).
+	slave, err := unix.Open(name, unix.O_RDWR|unix.O_NOCTTY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		unix.Close(master)
+		return nil, err
+	}
+
+	cfg := &unix.Termios{
+		Iflag: unix.IGNPAR,
+		Cflag: unix.CS8 | unix.CLOCAL | unix.CREAD,
+	}
+	if err := unix.IoctlSetTermios(slave, unix.TCSETS, cfg); err != nil {
+		unix.Close(slave)
+		unix.Close(master)
+		return nil, err
+	}
+
+	ptysMutex.Lock()
+	ptys[name] = true
+	ptysMutex.Unlock()
+
+	return &Pty{
+		master: master,
+		slave:  slave,
+		name:   name,
+	}, nil
+}
+
+func (p *Pty) Name() string {
+	return p.name
+}
+
+func (p *Pty) Close() error {
+	ptysMutex.Lock()
+	delete(ptys, p.name)
+	ptysMutex.Unlock()
+
+	if err := unix.Close(p.slave); err != nil {
+		unix.Close(p.master)
+		return err
+	}
+	return unix.Close(p.master)
+}
+
+// Poll waits up to timeout for data to be available for reading. A zero
+// timeout just checks.
+func (p *Pty) Poll(timeout time.Duration) (bool, error) {
+	fds := []unix.PollFd{
+		{
+			Fd:     int32(p.master),
+			Events: unix.POLLIN,
+		},
+	}
+	for {
+		n, err := unix.Poll(fds, int(timeout/time.Millisecond))
+		if err == unix.EINTR {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		return n == 1 && fds[0].Revents&unix.POLLIN != 0, nil
+	}
+}
+
+func (p *Pty) ReadByte() (byte, error) {
+	b := make([]byte, 1)
+	for {
+		n, err := unix.Read(p.master, b)
+		if err == unix.EINTR {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		if n == 0 {
+			return 0, fmt.Errorf("usbserialtest: pty: got unexpected EOF")
+		}
+		return b[0], nil
+	}
+}
+
+func (p *Pty) Write(b []byte) error {
+	n := 0
+	for n < len(b) {
+		c, err := unix.Write(p.master, b[n:])
+		if err == unix.EINTR {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		n += c
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package usbserialtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dwtk/dwtk/internal/usbserial"
+)
+
+func TestPtyBreak(t *testing.T) {
+	pty, err := OpenPty()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pty.Close()
+
+	u, err := usbserial.OpenRaw(pty.Name(), 62500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer u.Close()
+
+	// ptys have no modem lines.
+	if err := u.SetDTRRTS(true); err != nil {
+		t.Fatal(err)
+	}
+	if err := u.SendBreak(); err != nil {
+		t.Fatal(err)
+	}
+
+	ok, err := pty.Poll(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("break not received")
+	}
+	b, err := pty.ReadByte()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b != PtyBreak {
+		t.Fatalf("bad break: 0x%02x", b)
+	}
+}
+
+func TestPtyClosed(t *testing.T) {
+	pty, err := OpenPty()
+	if err != nil {
+		t.Fatal(err)
+	}
+	name := pty.Name()
+	pty.Close()
+
+	if l := emulatedLine(name, -1); l != nil {
+		t.Fatal("closed pty still emulates line signals")
+	}
+}